@@ -4,6 +4,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
@@ -14,24 +15,48 @@ import (
 	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
 )
 
+// openGL2Vertices maps the values of the -vertices flag to the ways the renderer passes vertices to OpenGL.
+var openGL2Vertices = map[string]renderers.OpenGL2Vertices{
+	"arrays":  renderers.OpenGL2ClientArrays,
+	"buffers": renderers.OpenGL2BufferObjects,
+	"vao":     renderers.OpenGL2VertexArrayObjects,
+}
+
 func main() {
+	vertices := renderers.OpenGL2ClientArrays
+	flag.Func("vertices", "how the renderer passes vertices to OpenGL: arrays (default), buffers, or vao", func(value string) error {
+		var known bool
+		vertices, known = openGL2Vertices[value]
+		if !known {
+			return fmt.Errorf("unknown value %q", value)
+		}
+		return nil
+	})
+	flags := example.ParseFlags()
+
 	context := imgui.CreateContext(nil)
 	defer context.Destroy()
 	io := imgui.CurrentIO()
 
-	platform, err := platforms.NewGLFW(io, platforms.GLFWClientAPIOpenGL2)
+	platform, err := platforms.NewGLFWV(io, platforms.GLFWClientAPIOpenGL2,
+		platforms.Options{Transparent: flags.Transparent, Borderless: flags.Borderless})
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(-1)
 	}
 	defer platform.Dispose()
 
-	renderer, err := renderers.NewOpenGL2(io)
+	renderer, err := renderers.NewOpenGL2V(io, vertices)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(-1)
 	}
 	defer renderer.Dispose()
+	renderer.SetTransparent(flags.Transparent)
 
-	example.Run(platform, renderer)
+	err = example.Run(platform, renderer, example.NewDemo(flags.Fonts()...))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(-1)
+	}
 }
@@ -14,11 +14,14 @@ import (
 )
 
 func main() {
+	flags := example.ParseFlags()
+
 	context := imgui.CreateContext(nil)
 	defer context.Destroy()
 	io := imgui.CurrentIO()
 
-	platform, err := platforms.NewGLFW(io, platforms.GLFWClientAPIOpenGL3)
+	platform, err := platforms.NewGLFWV(io, platforms.GLFWClientAPIOpenGL3,
+		platforms.Options{SRGB: true, Transparent: flags.Transparent, Borderless: flags.Borderless})
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(-1)
@@ -31,6 +34,11 @@ func main() {
 		os.Exit(-1)
 	}
 	defer renderer.Dispose()
+	renderer.SetTransparent(flags.Transparent)
 
-	example.Run(platform, renderer)
+	err = example.Run(platform, renderer, example.NewDemo(flags.Fonts()...))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(-1)
+	}
 }
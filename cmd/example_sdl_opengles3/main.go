@@ -0,0 +1,45 @@
+//go:build sdl
+// +build sdl
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/example"
+	"github.com/jetsetilly/imgui-go-examples/internal/platforms"
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
+)
+
+func main() {
+	flags := example.ParseFlags()
+
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	platform, err := platforms.NewSDLV(io, platforms.SDLClientAPIOpenGLES3,
+		platforms.Options{Transparent: flags.Transparent, Borderless: flags.Borderless})
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(-1)
+	}
+	defer platform.Dispose()
+
+	renderer, err := renderers.NewOpenGLES(io)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(-1)
+	}
+	defer renderer.Dispose()
+	renderer.SetTransparent(flags.Transparent)
+
+	err = example.Run(platform, renderer, example.NewDemo(flags.Fonts()...))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(-1)
+	}
+}
@@ -3,7 +3,11 @@ module github.com/jetsetilly/imgui-go-examples
 go 1.24.0
 
 require (
-	github.com/go-gl/glfw v0.0.0-20250301202403-da16c1255728
+	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20260823155953-d41da22a9587
+	github.com/go-text/typesetting v0.3.5
 	github.com/jetsetilly/imgui-go/v5 v5.0.2
 	github.com/veandco/go-sdl2 v0.4.40
+	golang.org/x/image v0.23.0
 )
+
+require golang.org/x/text v0.21.0 // indirect
@@ -0,0 +1,282 @@
+package example
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"time"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/demo"
+	"github.com/jetsetilly/imgui-go-examples/internal/fonts"
+	"github.com/jetsetilly/imgui-go-examples/internal/shaping"
+)
+
+const (
+	millisPerSecond = 1000
+
+	screenshotFilename = "screenshot.png"
+
+	sampleText = "ภาษาไทย测试조선말"
+
+	gradientHeight = 20
+)
+
+// Demo is an Application that shows some basic features of ImGui, as well as exposing the standard demo window.
+type Demo struct {
+	fontList []fonts.Font
+	fonts    *fonts.Manager
+	shaper   *shaping.Shaper
+
+	showDemoWindow    bool
+	showGoDemoWindow  bool
+	clearColor        [4]float32
+	f                 float32
+	counter           int
+	showAnotherWindow bool
+
+	capturePending bool
+	captureRegion  image.Rectangle
+	captureStatus  string
+
+	srgbRenderer SRGBRenderer
+	srgb         bool
+	srgbStatus   string
+
+	statsRenderer StatsRenderer
+	showStats     bool
+	cpuTime       time.Duration
+}
+
+// NewDemo returns a new instance of the demo application.
+// The given fonts replace the default font of imgui when the application is initialised. The first font becomes the default font.
+func NewDemo(fontList ...fonts.Font) *Demo {
+	return &Demo{
+		fontList:   fontList,
+		clearColor: [4]float32{0, 0, 0, 1},
+	}
+}
+
+// Init implements the Application interface.
+func (app *Demo) Init(p Platform, r Renderer) error {
+	if srgbRenderer, isSRGBRenderer := r.(SRGBRenderer); isSRGBRenderer {
+		app.srgbRenderer = srgbRenderer
+		app.srgb = srgbRenderer.SRGB()
+	}
+	if statsRenderer, isStatsRenderer := r.(StatsRenderer); isStatsRenderer {
+		app.statsRenderer = statsRenderer
+	}
+
+	if len(app.fontList) > 0 {
+		app.fonts = fonts.NewManager(imgui.CurrentIO().Fonts())
+		err := app.fonts.Load(app.fontList...)
+		if err == nil {
+			err = app.fonts.Build()
+		}
+		if err != nil {
+			app.fonts.Dispose()
+			app.fonts = nil
+			return err
+		}
+		r.RebuildFontsTexture()
+	}
+
+	// Scripts such as Thai need shaping to show their combining marks in place, which requires user textures.
+	// Without fonts, the shaper uses a default font, which does not cover the sample text.
+	if textures, hasTextures := r.(shaping.Textures); hasTextures {
+		var err error
+		app.shaper, err = shaping.NewShaper(textures, app.fontList...)
+		if err != nil {
+			app.Shutdown()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Shutdown implements the Application interface.
+func (app *Demo) Shutdown() {
+	if app.shaper != nil {
+		app.shaper.Dispose()
+		app.shaper = nil
+	}
+	if app.fonts != nil {
+		app.fonts.Dispose()
+		app.fonts = nil
+	}
+}
+
+// ClearColor implements the Application interface.
+func (app *Demo) ClearColor() [4]float32 {
+	return app.clearColor
+}
+
+// RenderScene implements the Application interface. The demo has no scene of its own.
+func (app *Demo) RenderScene() {
+}
+
+// ObserveFrameTime implements the FrameTimeObserver interface.
+func (app *Demo) ObserveFrameTime(cpuTime time.Duration) {
+	app.cpuTime = cpuTime
+}
+
+// AfterRender implements the AfterRenderer interface. It switches the sRGB mode of the renderer, and saves a requested screenshot.
+func (app *Demo) AfterRender(r Renderer) {
+	app.applySRGB()
+
+	if !app.capturePending {
+		return
+	}
+	app.capturePending = false
+
+	capturer, canCapture := r.(Capturer)
+	if !canCapture {
+		app.captureStatus = "The renderer does not support screenshots"
+		return
+	}
+	img, err := capturer.Capture(app.captureRegion)
+	if err == nil {
+		err = savePNG(screenshotFilename, img)
+	}
+	if err != nil {
+		app.captureStatus = fmt.Sprintf("Screenshot failed: %v", err)
+		return
+	}
+	app.captureStatus = fmt.Sprintf("Screenshot saved to %s", screenshotFilename)
+}
+
+// applySRGB switches the renderer to the sRGB mode selected in the UI, which must not happen during a frame.
+func (app *Demo) applySRGB() {
+	if (app.srgbRenderer == nil) || (app.srgb == app.srgbRenderer.SRGB()) {
+		return
+	}
+	err := app.srgbRenderer.SetSRGB(app.srgb)
+	if err != nil {
+		app.srgb = false
+		app.srgbStatus = fmt.Sprintf("sRGB mode failed: %v", err)
+		return
+	}
+	app.srgbStatus = ""
+}
+
+func savePNG(filename string, img image.Image) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(file, img)
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// Frame implements the Application interface.
+func (app *Demo) Frame() {
+	// 1. Show a simple window.
+	// Tip: if we don't call imgui.Begin()/imgui.End() the widgets automatically appears in a window called "Debug".
+	{
+		// To display these, you'll need to register a compatible font (see NewDemo(), and the -font flag of the examples)
+		if app.shaper != nil {
+			app.shaper.Text(sampleText)
+		} else {
+			imgui.Text(sampleText)
+		}
+		imgui.Text("Hello, world!")                      // Display some text
+		imgui.SliderFloat("float", &app.f, 0.0, 1.0)     // Edit 1 float using a slider from 0.0f to 1.0f
+		imgui.ColorEdit4("clear color", &app.clearColor) // Edit 4 floats representing a color, with alpha for transparent windows
+
+		imgui.Checkbox("Demo Window", &app.showDemoWindow) // Edit bools storing our window open/close state
+		imgui.Checkbox("Go Demo Window", &app.showGoDemoWindow)
+		imgui.Checkbox("Another Window", &app.showAnotherWindow)
+
+		if imgui.Button("Button") { // Buttons return true when clicked (most widgets return true when edited/activated)
+			app.counter++
+		}
+		imgui.SameLine()
+		imgui.Text(fmt.Sprintf("counter = %d", app.counter))
+
+		imgui.Text(fmt.Sprintf("Application average %.3f ms/frame (%.1f FPS)",
+			millisPerSecond/imgui.CurrentIO().Framerate(), imgui.CurrentIO().Framerate()))
+		if app.statsRenderer != nil {
+			imgui.Checkbox("Renderer Stats", &app.showStats)
+		}
+
+		// Screenshots are taken after the frame has been rendered, see AfterRender()
+		if imgui.Button("Screenshot") {
+			app.capturePending = true
+			app.captureRegion = image.Rectangle{}
+		}
+		imgui.SameLine()
+		if imgui.Button("Screenshot of this window") {
+			pos, size := imgui.WindowPos(), imgui.WindowSize()
+			app.capturePending = true
+			app.captureRegion = image.Rect(int(pos.X), int(pos.Y), int(pos.X+size.X), int(pos.Y+size.Y))
+		}
+		if app.captureStatus != "" {
+			imgui.Text(app.captureStatus)
+		}
+
+		// The renderer is switched after the frame has been rendered, see AfterRender()
+		if app.srgbRenderer != nil {
+			imgui.Checkbox("sRGB framebuffer", &app.srgb)
+			if app.srgbStatus != "" {
+				imgui.Text(app.srgbStatus)
+			}
+			showGradients()
+		}
+	}
+
+	// 2. Show another simple window. In most cases you will use an explicit Begin/End pair to name your windows.
+	if app.showAnotherWindow {
+		// Pass a pointer to our bool variable (the window will have a closing button that will clear the bool when clicked)
+		imgui.BeginV("Another window", &app.showAnotherWindow, 0)
+		imgui.Text("Hello from another window!")
+		if imgui.Button("Close Me") {
+			app.showAnotherWindow = false
+		}
+		imgui.End()
+	}
+
+	// 3. Show the ImGui demo window. Most of the sample code is in imgui.ShowDemoWindow().
+	// Read its code to learn more about Dear ImGui!
+	if app.showDemoWindow {
+		// Normally user code doesn't need/want to call this because positions are saved in .ini file anyway.
+		// Here we just want to make the demo initial state a bit more friendly!
+		const demoX = 650
+		const demoY = 20
+		imgui.SetNextWindowPosV(imgui.Vec2{X: demoX, Y: demoY}, imgui.ConditionFirstUseEver, imgui.Vec2{})
+
+		imgui.ShowDemoWindow(&app.showDemoWindow)
+	}
+	if app.showGoDemoWindow {
+		demo.Show(&app.showGoDemoWindow)
+	}
+
+	// 4. Show the numbers of the renderer, to tell whether the frames are limited by the CPU or the GPU.
+	if app.showStats {
+		ShowStatsOverlay(&app.showStats, app.cpuTime, app.statsRenderer.Stats())
+	}
+}
+
+// showGradients draws gradients that show the difference between blending in gamma space and in linear space:
+// In linear space, the gradients are brighter in the middle, and the one from red to green does not turn muddy.
+func showGradients() {
+	gradients := [][2]imgui.Vec4{
+		{{X: 0, Y: 0, Z: 0, W: 1}, {X: 1, Y: 1, Z: 1, W: 1}},
+		{{X: 1, Y: 0, Z: 0, W: 1}, {X: 0, Y: 1, Z: 0, W: 1}},
+	}
+	list := imgui.WindowDrawList()
+	width := imgui.ContentRegionAvail().X
+	for _, gradient := range gradients {
+		min := imgui.CursorScreenPos()
+		max := imgui.Vec2{X: min.X + width, Y: min.Y + gradientHeight}
+		left, right := imgui.PackedColorFromVec4(gradient[0]), imgui.PackedColorFromVec4(gradient[1])
+		list.AddRectFilledMultiColor(min, max, left, right, right, left)
+		imgui.Dummy(imgui.Vec2{X: width, Y: gradientHeight})
+	}
+}
@@ -0,0 +1,105 @@
+package example
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/fonts"
+)
+
+const defaultFontSize = 16
+
+// sampleScripts are the scripts of the sample text of the demo.
+var sampleScripts = []fonts.Script{fonts.ScriptDefault, fonts.ScriptThai, fonts.ScriptChineseSimplifiedCommon, fonts.ScriptKorean}
+
+// Flags are the command line flags that all example programs share.
+type Flags struct {
+	// FontPaths lists the TTF/OTF files given with -font. The glyphs of the later files are merged into the first one.
+	FontPaths []string
+	// FontSize is the height of the fonts, in pixels.
+	FontSize float32
+	// GoRasterizer selects the Go rasterizer for the fonts, see fonts.RasterizerOptions.
+	GoRasterizer bool
+	// FontVariations select the instance of variable fonts, with the Go rasterizer.
+	FontVariations []fonts.Variation
+	// Transparent requests a transparent window, which shows the desktop through the alpha of the clear color.
+	Transparent bool
+	// Borderless requests a window without decorations.
+	Borderless bool
+}
+
+// ParseFlags defines the shared flags and parses the command line.
+// Programs with additional flags define them with the flag package before calling this function.
+func ParseFlags() Flags {
+	var flags Flags
+	flag.Var((*pathList)(&flags.FontPaths), "font",
+		"TTF/OTF `file` to load instead of the default font, such as one covering the sample text. Repeat to merge the glyphs of further files")
+	fontSize := flag.Float64("font-size", defaultFontSize, "height of the fonts in `pixels`")
+	flag.BoolVar(&flags.GoRasterizer, "go-rasterizer", false, "rasterize the glyphs of the fonts given with -font in Go instead of with imgui")
+	flag.Var((*variationList)(&flags.FontVariations), "font-variation",
+		"`axis=value` of variable fonts, such as wght=300, for the Go rasterizer. The glyphs must fit into the ones of the default instance. Repeat for further axes")
+	flag.BoolVar(&flags.Transparent, "transparent", false, "request a transparent window, through which the desktop shows where the clear color is transparent")
+	flag.BoolVar(&flags.Borderless, "borderless", false, "request a window without decorations")
+	flag.Parse()
+	flags.FontSize = float32(*fontSize)
+	if len(flags.FontVariations) > 0 {
+		flags.GoRasterizer = true
+	}
+	return flags
+}
+
+// Fonts returns the fonts to pass to NewDemo(). They cover the scripts of the sample text.
+func (flags Flags) Fonts() []fonts.Font {
+	var list []fonts.Font
+	for i, path := range flags.FontPaths {
+		font := fonts.Font{
+			Path:    path,
+			Size:    flags.FontSize,
+			Scripts: sampleScripts,
+			Merge:   i > 0,
+		}
+		if flags.GoRasterizer {
+			font.Rasterizer = &fonts.RasterizerOptions{Variations: flags.FontVariations}
+		}
+		list = append(list, font)
+	}
+	return list
+}
+
+// pathList is a flag that can be given several times.
+type pathList []string
+
+func (list *pathList) String() string {
+	return strings.Join(*list, ",")
+}
+
+func (list *pathList) Set(path string) error {
+	*list = append(*list, path)
+	return nil
+}
+
+// variationList is a flag of variations that can be given several times.
+type variationList []fonts.Variation
+
+func (list *variationList) String() string {
+	var values []string
+	for _, variation := range *list {
+		values = append(values, fmt.Sprintf("%s=%v", variation.Axis, variation.Value))
+	}
+	return strings.Join(values, ",")
+}
+
+func (list *variationList) Set(value string) error {
+	axis, number, found := strings.Cut(value, "=")
+	if !found {
+		return fmt.Errorf("variation %q is not of the form axis=value", value)
+	}
+	parsed, err := strconv.ParseFloat(number, 32)
+	if err != nil {
+		return err
+	}
+	*list = append(*list, fonts.Variation{Axis: axis, Value: float32(parsed)})
+	return nil
+}
@@ -0,0 +1,112 @@
+package example
+
+import (
+	"time"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Pacing describes how the program loop spaces its frames.
+//
+// With a TargetFPS of zero, the loop runs as fast as the platform allows. As the platforms
+// enable vsync, this means one frame per display refresh ("vsync-only" mode).
+type Pacing struct {
+	// TargetFPS is the maximum number of frames per second. Zero disables the cap.
+	TargetFPS int
+
+	// Idle enables idle mode: Once there has been no input for a few frames, the application is not
+	// animating, no imgui item is active, and the window does not have the input focus, the loop
+	// blocks on platform events instead of rendering new frames.
+	Idle bool
+
+	// IdleTimeout is the longest time the loop blocks in idle mode. A frame is rendered after
+	// this time even without any events, so that time-based imgui features (such as tooltips)
+	// still get updated.
+	IdleTimeout time.Duration
+}
+
+// Animator may be implemented by an Application that changes its output independently of user input.
+// While Animating() returns true, the program loop does not enter idle mode.
+type Animator interface {
+	Animating() bool
+}
+
+// FocusReporter may be implemented by a Platform that knows whether its window has the input focus.
+// While Focused() returns true, the program loop does not enter idle mode.
+type FocusReporter interface {
+	Focused() bool
+}
+
+const (
+	defaultIdleTimeout = time.Millisecond * 250
+
+	// idleFrames is the number of frames without any events before idle mode is entered.
+	// imgui needs a few frames to settle after input, e.g. for hover states and window positions.
+	idleFrames = 3
+)
+
+// DefaultPacing returns the pacing used by Run(): vsync without any frame cap, and idle mode enabled.
+func DefaultPacing() Pacing {
+	return Pacing{
+		TargetFPS:   0,
+		Idle:        true,
+		IdleTimeout: defaultIdleTimeout,
+	}
+}
+
+type pacer struct {
+	pacing      Pacing
+	frameStart  time.Time
+	quietFrames int
+}
+
+func newPacer(pacing Pacing) *pacer {
+	return &pacer{pacing: pacing}
+}
+
+// processEvents dispatches the pending events of the platform. In idle mode, it
+// blocks until new events arrive or the idle timeout elapses.
+func (pacer *pacer) processEvents(p Platform, app Application) {
+	timeout := time.Duration(0)
+	if pacer.idle(p, app) {
+		timeout = pacer.pacing.IdleTimeout
+	}
+
+	if p.ProcessEvents(timeout) {
+		pacer.quietFrames = 0
+	} else if pacer.quietFrames < idleFrames {
+		pacer.quietFrames++
+	}
+
+	pacer.frameStart = time.Now()
+}
+
+// elapsed returns the time since the events of the current frame were processed.
+func (pacer *pacer) elapsed() time.Duration {
+	return time.Since(pacer.frameStart)
+}
+
+// endFrame waits for the remainder of the frame time, if a frame cap is set.
+func (pacer *pacer) endFrame() {
+	if pacer.pacing.TargetFPS <= 0 {
+		return
+	}
+	frameDuration := time.Second / time.Duration(pacer.pacing.TargetFPS)
+	remaining := frameDuration - time.Since(pacer.frameStart)
+	if remaining > 0 {
+		time.Sleep(remaining)
+	}
+}
+
+func (pacer *pacer) idle(p Platform, app Application) bool {
+	if !pacer.pacing.Idle || (pacer.quietFrames < idleFrames) {
+		return false
+	}
+	if focusReporter, isFocusReporter := p.(FocusReporter); isFocusReporter && focusReporter.Focused() {
+		return false
+	}
+	if animator, isAnimator := app.(Animator); isAnimator && animator.Animating() {
+		return false
+	}
+	return !imgui.IsAnyItemActive()
+}
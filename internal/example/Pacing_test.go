@@ -0,0 +1,122 @@
+package example
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// fakePlatform records the timeouts it is asked to wait for, and reports events when told to.
+type fakePlatform struct {
+	focused  bool
+	events   bool
+	timeouts []time.Duration
+}
+
+func (fake *fakePlatform) ShouldStop() bool { return false }
+
+func (fake *fakePlatform) ProcessEvents(timeout time.Duration) bool {
+	fake.timeouts = append(fake.timeouts, timeout)
+	events := fake.events
+	fake.events = false
+	return events
+}
+
+func (fake *fakePlatform) DisplaySize() [2]float32        { return [2]float32{} }
+func (fake *fakePlatform) FramebufferSize() [2]float32    { return [2]float32{} }
+func (fake *fakePlatform) NewFrame()                      {}
+func (fake *fakePlatform) PostRender()                    {}
+func (fake *fakePlatform) ClipboardText() (string, error) { return "", nil }
+func (fake *fakePlatform) SetClipboardText(text string)   {}
+func (fake *fakePlatform) Focused() bool                  { return fake.focused }
+
+type fakeApplication struct {
+	animating bool
+}
+
+func (fake *fakeApplication) Init(p Platform, r Renderer) error { return nil }
+func (fake *fakeApplication) Frame()                            {}
+func (fake *fakeApplication) ClearColor() [4]float32            { return [4]float32{} }
+func (fake *fakeApplication) RenderScene()                      {}
+func (fake *fakeApplication) Shutdown()                         {}
+func (fake *fakeApplication) Animating() bool                   { return fake.animating }
+
+// runFrames processes the events of the given number of frames, and returns the timeout of the last frame.
+func runFrames(pacer *pacer, p *fakePlatform, app Application, frames int) time.Duration {
+	for i := 0; i < frames; i++ {
+		pacer.processEvents(p, app)
+	}
+	return p.timeouts[len(p.timeouts)-1]
+}
+
+func TestPacerIdle(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	pacing := DefaultPacing()
+	tests := []struct {
+		name      string
+		focused   bool
+		animating bool
+		want      time.Duration
+	}{
+		{name: "quiet", want: pacing.IdleTimeout},
+		{name: "focused", focused: true, want: 0},
+		{name: "animating", animating: true, want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &fakePlatform{focused: test.focused}
+			app := &fakeApplication{animating: test.animating}
+			pacer := newPacer(pacing)
+
+			if timeout := runFrames(pacer, p, app, idleFrames); timeout != 0 {
+				t.Fatalf("waited %v before the frames without events, want no wait", timeout)
+			}
+			if timeout := runFrames(pacer, p, app, 1); timeout != test.want {
+				t.Fatalf("waited %v, want %v", timeout, test.want)
+			}
+		})
+	}
+}
+
+func TestPacerIdleEndsWithEvents(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	p := &fakePlatform{}
+	app := &fakeApplication{}
+	pacer := newPacer(DefaultPacing())
+
+	runFrames(pacer, p, app, idleFrames+1)
+	p.events = true
+	if timeout := runFrames(pacer, p, app, 2); timeout != 0 {
+		t.Fatalf("waited %v after events, want no wait", timeout)
+	}
+	if timeout := runFrames(pacer, p, app, idleFrames); timeout == 0 {
+		t.Fatalf("did not return to idle mode after %d frames without events", idleFrames)
+	}
+}
+
+func TestPacerIdleDisabled(t *testing.T) {
+	p := &fakePlatform{}
+	pacer := newPacer(Pacing{})
+
+	if timeout := runFrames(pacer, p, &fakeApplication{}, idleFrames+1); timeout != 0 {
+		t.Fatalf("waited %v without idle mode, want no wait", timeout)
+	}
+}
+
+func TestPacerFrameCap(t *testing.T) {
+	const targetFPS = 50
+	p := &fakePlatform{}
+	pacer := newPacer(Pacing{TargetFPS: targetFPS})
+
+	start := time.Now()
+	pacer.processEvents(p, &fakeApplication{})
+	pacer.endFrame()
+	if elapsed := time.Since(start); elapsed < time.Second/targetFPS {
+		t.Fatalf("frame took %v, want at least %v", elapsed, time.Second/targetFPS)
+	}
+}
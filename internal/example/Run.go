@@ -2,11 +2,10 @@ package example
 
 import (
 	"fmt"
+	"image"
 	"time"
 
 	"github.com/jetsetilly/imgui-go/v5"
-
-	"github.com/jetsetilly/imgui-go-examples/internal/demo"
 )
 
 // Platform covers mouse/keyboard/gamepad inputs, cursor shape, timing, windowing.
@@ -14,7 +13,9 @@ type Platform interface {
 	// ShouldStop is regularly called as the abort condition for the program loop.
 	ShouldStop() bool
 	// ProcessEvents is called once per render loop to dispatch any pending events.
-	ProcessEvents()
+	// If no events are pending, it waits up to the given timeout for events to arrive. A timeout of zero does not wait.
+	// It returns true if any events were dispatched.
+	ProcessEvents(timeout time.Duration) bool
 	// DisplaySize returns the dimension of the display.
 	DisplaySize() [2]float32
 	// FramebufferSize returns the dimension of the framebuffer.
@@ -31,95 +32,110 @@ type Platform interface {
 
 // Renderer covers rendering imgui draw data.
 type Renderer interface {
-	// PreRender causes the display buffer to be prepared for new output.
-	PreRender(clearColor [3]float32)
-	// Render draws the provided imgui draw data.
-	Render(displaySize [2]float32, framebufferSize [2]float32, drawData imgui.DrawData)
+	// NewFrame is called before imgui.NewFrame(). It must recreate the font texture if the font atlas has changed.
+	NewFrame()
+	// PreRender causes the display buffer to be prepared for new output. The clear color has straight alpha.
+	PreRender(clearColor [4]float32)
+	// Render draws the provided imgui draw data. The draw data determines the area that is rendered,
+	// through its display position, display size, and framebuffer scale.
+	Render(drawData imgui.DrawData)
+	// RebuildFontsTexture replaces the font texture with one created from the current state of the font atlas.
+	RebuildFontsTexture()
 }
 
-const (
-	millisPerSecond = 1000
-	sleepDuration   = time.Millisecond * 25
-)
+// Capturer is implemented by renderers that can read back the rendered frame.
+type Capturer interface {
+	// Capture returns the pixels of the frame that was last rendered. It must be called after Render() and
+	// before the display buffer is swapped. The region is given in display coordinates. An empty region captures
+	// the whole frame.
+	Capture(region image.Rectangle) (*image.RGBA, error)
+}
+
+// SRGBRenderer is implemented by renderers that can blend in linear space, with a framebuffer that encodes to sRGB.
+type SRGBRenderer interface {
+	// SetSRGB selects whether the renderer blends in linear space. It must not be called between
+	// imgui.NewFrame() and Render().
+	SetSRGB(enabled bool) error
+	// SRGB returns true if the renderer blends in linear space.
+	SRGB() bool
+}
+
+// Application is the part of a program that is run by Run(). It provides the UI and
+// any additional rendering, while the program loop itself is handled by Run().
+type Application interface {
+	// Init is called once, before the first frame. An error stops Run() before the loop is entered.
+	Init(p Platform, r Renderer) error
+	// Frame is called once per render loop, between imgui.NewFrame() and imgui.Render(). This is where the UI is created.
+	Frame()
+	// ClearColor returns the color the display buffer is cleared with at the start of each render pass.
+	// The alpha channel only has an effect on transparent windows, see platforms.Options, and only if the renderer
+	// writes premultiplied alpha for them, see for example renderers.OpenGL3.SetTransparent().
+	ClearColor() [4]float32
+	// RenderScene is called after the display buffer has been cleared and before the imgui draw data is rendered.
+	// This is where the application can perform its own rendering.
+	RenderScene()
+	// Shutdown is called once, after the program loop has ended.
+	Shutdown()
+}
+
+// AfterRenderer may be implemented by an Application that needs to access the rendered frame, for example to capture it.
+type AfterRenderer interface {
+	// AfterRender is called once per render loop, after the imgui draw data has been rendered and before the display buffer is swapped.
+	AfterRender(r Renderer)
+}
+
+// FrameTimeObserver may be implemented by an Application that shows how long its frames take.
+type FrameTimeObserver interface {
+	// ObserveFrameTime is called once per render loop, after the imgui draw data has been rendered, with the CPU time of the
+	// frame: From the end of event processing to the end of Render(), without waiting for the display buffer to be swapped.
+	ObserveFrameTime(cpuTime time.Duration)
+}
+
+// Run implements the main program loop with DefaultPacing(). It returns when the platform signals to stop.
+// The application is initialised before the loop is entered and shut down once the loop has ended.
+func Run(p Platform, r Renderer, app Application) error {
+	return RunV(p, r, app, DefaultPacing())
+}
 
-// Run implements the main program loop of the demo. It returns when the platform signals to stop.
-// This demo application shows some basic features of ImGui, as well as exposing the standard demo window.
-func Run(p Platform, r Renderer) {
+// RunV implements the main program loop, with frames spaced according to the given pacing.
+func RunV(p Platform, r Renderer, app Application, pacing Pacing) error {
 	imgui.CurrentPlatformIO().SetClipboard(p)
 
-	showDemoWindow := false
-	showGoDemoWindow := false
-	clearColor := [3]float32{0.0, 0.0, 0.0}
-	f := float32(0)
-	counter := 0
-	showAnotherWindow := false
+	err := app.Init(p, r)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer app.Shutdown()
+
+	pacer := newPacer(pacing)
 
 	for !p.ShouldStop() {
-		p.ProcessEvents()
+		pacer.processEvents(p, app)
 
 		// Signal start of a new frame
 		p.NewFrame()
+		r.NewFrame()
 		imgui.NewFrame()
 
-		// 1. Show a simple window.
-		// Tip: if we don't call imgui.Begin()/imgui.End() the widgets automatically appears in a window called "Debug".
-		{
-			imgui.Text("ภาษาไทย测试조선말")                   // To display these, you'll need to register a compatible font
-			imgui.Text("Hello, world!")                  // Display some text
-			imgui.SliderFloat("float", &f, 0.0, 1.0)     // Edit 1 float using a slider from 0.0f to 1.0f
-			imgui.ColorEdit3("clear color", &clearColor) // Edit 3 floats representing a color
-
-			imgui.Checkbox("Demo Window", &showDemoWindow) // Edit bools storing our window open/close state
-			imgui.Checkbox("Go Demo Window", &showGoDemoWindow)
-			imgui.Checkbox("Another Window", &showAnotherWindow)
-
-			if imgui.Button("Button") { // Buttons return true when clicked (most widgets return true when edited/activated)
-				counter++
-			}
-			imgui.SameLine()
-			imgui.Text(fmt.Sprintf("counter = %d", counter))
-
-			imgui.Text(fmt.Sprintf("Application average %.3f ms/frame (%.1f FPS)",
-				millisPerSecond/imgui.CurrentIO().Framerate(), imgui.CurrentIO().Framerate()))
-		}
-
-		// 2. Show another simple window. In most cases you will use an explicit Begin/End pair to name your windows.
-		if showAnotherWindow {
-			// Pass a pointer to our bool variable (the window will have a closing button that will clear the bool when clicked)
-			imgui.BeginV("Another window", &showAnotherWindow, 0)
-			imgui.Text("Hello from another window!")
-			if imgui.Button("Close Me") {
-				showAnotherWindow = false
-			}
-			imgui.End()
-		}
-
-		// 3. Show the ImGui demo window. Most of the sample code is in imgui.ShowDemoWindow().
-		// Read its code to learn more about Dear ImGui!
-		if showDemoWindow {
-			// Normally user code doesn't need/want to call this because positions are saved in .ini file anyway.
-			// Here we just want to make the demo initial state a bit more friendly!
-			const demoX = 650
-			const demoY = 20
-			imgui.SetNextWindowPosV(imgui.Vec2{X: demoX, Y: demoY}, imgui.ConditionFirstUseEver, imgui.Vec2{})
-
-			imgui.ShowDemoWindow(&showDemoWindow)
-		}
-		if showGoDemoWindow {
-			demo.Show(&showGoDemoWindow)
-		}
+		app.Frame()
 
 		// Rendering
 		imgui.Render() // This call only creates the draw data list. Actual rendering to framebuffer is done below.
 
-		r.PreRender(clearColor)
-		// A this point, the application could perform its own rendering...
-		// app.RenderScene()
+		r.PreRender(app.ClearColor())
+		app.RenderScene()
 
-		r.Render(p.DisplaySize(), p.FramebufferSize(), imgui.RenderedDrawData())
+		r.Render(imgui.RenderedDrawData())
+		if observer, isObserver := app.(FrameTimeObserver); isObserver {
+			observer.ObserveFrameTime(pacer.elapsed())
+		}
+		if afterRenderer, isAfterRenderer := app.(AfterRenderer); isAfterRenderer {
+			afterRenderer.AfterRender(r)
+		}
 		p.PostRender()
 
-		// sleep to avoid 100% CPU usage for this demo
-		<-time.After(sleepDuration)
+		pacer.endFrame()
 	}
+
+	return nil
 }
@@ -0,0 +1,57 @@
+package example
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
+)
+
+// StatsRenderer may be implemented by a Renderer that collects numbers about the frames it renders.
+type StatsRenderer interface {
+	// Stats returns the numbers of the previous frame.
+	Stats() renderers.FrameStats
+}
+
+const (
+	statsOverlayMargin = 10
+	statsOverlayAlpha  = 0.35
+)
+
+// ShowStatsOverlay shows a small window in the top right corner of the display, with the CPU time of the frame
+// and the numbers the renderer collected.
+//
+// The frame time is the interval between frames, which includes waiting for the display. If the CPU time is close to it,
+// the frame rate is limited by the CPU, if the GPU time is close to it, by the GPU. If both are well below it,
+// the frame rate is limited by vsync or the frame cap.
+func ShowStatsOverlay(open *bool, cpuTime time.Duration, stats renderers.FrameStats) {
+	io := imgui.CurrentIO()
+	imgui.SetNextWindowPosV(imgui.Vec2{X: io.DisplaySize().X - statsOverlayMargin, Y: statsOverlayMargin},
+		imgui.ConditionAlways, imgui.Vec2{X: 1, Y: 0})
+	imgui.SetNextWindowBgAlpha(statsOverlayAlpha)
+	flags := imgui.WindowFlagsNoDecoration | imgui.WindowFlagsAlwaysAutoResize | imgui.WindowFlagsNoSavedSettings |
+		imgui.WindowFlagsNoFocusOnAppearing | imgui.WindowFlagsNoNav | imgui.WindowFlagsNoMove
+	if imgui.BeginV("Renderer stats", open, flags) {
+		imgui.Text(fmt.Sprintf("Frame time: %.3f ms", millisPerSecond/io.Framerate()))
+		imgui.Text(fmt.Sprintf("CPU time:   %.3f ms", millis(cpuTime)))
+		if stats.HasGPUTime {
+			imgui.Text(fmt.Sprintf("GPU time:   %.3f ms", millis(stats.GPUTime)))
+		} else {
+			imgui.Text("GPU time:   not available")
+		}
+		imgui.Separator()
+		imgui.Text(fmt.Sprintf("Draw calls:      %d", stats.DrawCalls))
+		imgui.Text(fmt.Sprintf("Triangles:       %d", stats.Triangles))
+		imgui.Text(fmt.Sprintf("Vertices:        %d", stats.Vertices))
+		imgui.Text(fmt.Sprintf("Texture binds:   %d", stats.TextureBinds))
+		imgui.Text(fmt.Sprintf("Scissor changes: %d", stats.ScissorChanges))
+		imgui.Text(fmt.Sprintf("Uploaded:        %.1f KiB", float64(stats.UploadBytes)/1024))
+	}
+	imgui.End()
+}
+
+func millis(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
@@ -1,5 +1,6 @@
 // Package example contains the core logic of the demo.
-// The Run() function demonstrates how a typical application would create a UI.
-// The function herein is not concerned about technology-specific things, such as
+// The Run() function implements the program loop and drives an Application, which creates the UI.
+// Demo is the Application of the example programs and demonstrates how a typical application would create a UI.
+// The functions herein are not concerned about technology-specific things, such as
 // which abstraction library or which drawing interface is used.
 package example
@@ -0,0 +1,262 @@
+package fonts
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"unsafe"
+
+	gotext "github.com/go-text/typesetting/font"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Script identifies one of the glyph ranges predefined by imgui.
+type Script int
+
+// This is a list of Script constants.
+const (
+	// ScriptDefault covers Basic Latin and the Latin-1 Supplement.
+	ScriptDefault Script = iota
+	ScriptCyrillic
+	ScriptThai
+	ScriptKorean
+	ScriptJapanese
+	ScriptChineseFull
+	ScriptChineseSimplifiedCommon
+)
+
+// GlyphRange is an inclusive range of code points.
+type GlyphRange struct {
+	From rune
+	To   rune
+}
+
+// GlyphRangePrivateUse is the Private Use Area of the Basic Multilingual Plane,
+// which is where most icon fonts place their glyphs.
+var GlyphRangePrivateUse = GlyphRange{From: 0xE000, To: 0xF8FF}
+
+// Font describes a font file to be loaded into the atlas.
+type Font struct {
+	// FS is the file system the font is read from. If nil, it is read from the local file system.
+	FS fs.FS
+	// Path is the name of the TTF/OTF file.
+	Path string
+
+	// Size is the height of the font, in pixels.
+	Size float32
+	// OversampleH and OversampleV control the horizontal and vertical oversampling when rasterizing glyphs.
+	// Zero keeps the default of imgui.
+	OversampleH int
+	OversampleV int
+
+	// Scripts and Ranges list the glyphs to load from the font. If both are empty, ScriptDefault is used.
+	Scripts []Script
+	Ranges  []GlyphRange
+
+	// Merge adds the glyphs of the font to the previously added font, instead of creating a new font.
+	// This is typically used for icon fonts, or to add glyphs of other scripts to a base font.
+	Merge bool
+	// GlyphMinAdvanceX is the minimum horizontal advance of each glyph. Use this to make icons monospaced.
+	GlyphMinAdvanceX float32
+	// GlyphOffset is added to the position of each glyph. Use this to align icons with the text of the base font.
+	GlyphOffset imgui.Vec2
+
+	// Rasterizer selects the Go rasterizer for the glyphs of the font, see RasterizerOptions.
+	// If nil, imgui rasterizes the glyphs.
+	Rasterizer *RasterizerOptions
+}
+
+// Manager loads fonts into an imgui font atlas and keeps the resources alive that the atlas refers to.
+//
+// The font atlas must not be modified while a frame is being created, i.e. between imgui.NewFrame() and imgui.Render().
+// After fonts have been loaded, the renderer must rebuild its font texture.
+type Manager struct {
+	atlas  imgui.FontAtlas
+	ranges []imgui.AllocatedGlyphRanges
+	added  []addedFont
+}
+
+// NewManager returns a manager for the given font atlas.
+func NewManager(atlas imgui.FontAtlas) *Manager {
+	return &Manager{atlas: atlas}
+}
+
+// Dispose removes all fonts from the atlas and releases the glyph ranges.
+func (manager *Manager) Dispose() {
+	manager.Clear()
+}
+
+// Clear removes all fonts from the atlas, including the default font.
+func (manager *Manager) Clear() {
+	manager.atlas.Clear()
+	for i := range manager.ranges {
+		manager.ranges[i].Free()
+	}
+	manager.ranges = nil
+	manager.added = nil
+}
+
+// Load replaces all fonts of the atlas with the given fonts. The first font becomes the default font.
+func (manager *Manager) Load(fonts ...Font) error {
+	manager.Clear()
+	return manager.Add(fonts...)
+}
+
+// Add adds the fonts to the atlas, in the given order.
+// It returns an error on the first font that could not be added. Fonts before that remain in the atlas.
+func (manager *Manager) Add(fonts ...Font) error {
+	for _, font := range fonts {
+		err := manager.add(font)
+		if err != nil {
+			return fmt.Errorf("failed to add font %q: %w", font.Path, err)
+		}
+	}
+	return nil
+}
+
+func (manager *Manager) add(font Font) error {
+	if font.Merge && (len(manager.ranges) == 0) {
+		return ErrNothingToMerge
+	}
+	if font.Size <= 0 {
+		return ErrInvalidFontSize
+	}
+
+	var data []byte
+	var err error
+	if font.FS != nil {
+		data, err = fs.ReadFile(font.FS, font.Path)
+	} else {
+		data, err = os.ReadFile(font.Path)
+	}
+	if err != nil {
+		return err
+	}
+
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	config.SetName(font.Path)
+	config.SetMergeMode(font.Merge)
+	if font.OversampleH > 0 {
+		config.SetOversampleH(font.OversampleH)
+	}
+	if font.OversampleV > 0 {
+		config.SetOversampleV(font.OversampleV)
+	}
+	config.SetGlyphMinAdvanceX(font.GlyphMinAdvanceX)
+	config.SetGlyphOffsetX(font.GlyphOffset.X)
+	config.SetGlyphOffsetY(font.GlyphOffset.Y)
+
+	if font.Rasterizer != nil {
+		_, err = gotext.ParseTTF(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		for _, variation := range font.Rasterizer.Variations {
+			if len(variation.Axis) != 4 {
+				return fmt.Errorf("%w: %q", ErrInvalidVariationAxis, variation.Axis)
+			}
+		}
+		// The Go rasterizer draws the glyphs at their size in pixels.
+		config.SetOversampleH(1)
+		config.SetOversampleV(1)
+		config.SetPixelSnapH(true)
+	}
+
+	ranges := manager.glyphRanges(font)
+	target := manager.atlas.AddFontFromMemoryTTFV(data, font.Size, config, ranges.GlyphRanges)
+	if target == imgui.DefaultFont {
+		ranges.Free()
+		return ErrFontNotAdded
+	}
+	// The atlas refers to the glyph ranges whenever it is built, so they are kept until the atlas is cleared.
+	manager.ranges = append(manager.ranges, ranges)
+	added := addedFont{
+		path:   font.Path,
+		target: target,
+		data:   data,
+		size:   font.Size,
+		ranges: glyphRangeList(ranges.GlyphRanges, manager.glyphRangeEntrySize()),
+	}
+	if font.Rasterizer != nil {
+		options := *font.Rasterizer
+		added.options = &options
+	}
+	manager.added = append(manager.added, added)
+	return nil
+}
+
+func (manager *Manager) glyphRanges(font Font) imgui.AllocatedGlyphRanges {
+	var builder imgui.GlyphRangesBuilder
+
+	scripts := font.Scripts
+	if (len(scripts) == 0) && (len(font.Ranges) == 0) {
+		scripts = []Script{ScriptDefault}
+	}
+	for _, script := range scripts {
+		builder.AddExisting(manager.scriptRanges(script))
+	}
+	for _, r := range font.Ranges {
+		builder.Add(r.From, r.To)
+	}
+
+	return builder.Build()
+}
+
+func (manager *Manager) scriptRanges(script Script) imgui.GlyphRanges {
+	switch script {
+	case ScriptCyrillic:
+		return manager.atlas.GlyphRangesCyrillic()
+	case ScriptThai:
+		return manager.atlas.GlyphRangesThai()
+	case ScriptKorean:
+		return manager.atlas.GlyphRangesKorean()
+	case ScriptJapanese:
+		return manager.atlas.GlyphRangesJapanese()
+	case ScriptChineseFull:
+		return manager.atlas.GlyphRangesChineseFull()
+	case ScriptChineseSimplifiedCommon:
+		return manager.atlas.GlyphRangesChineseSimplifiedCommon()
+	default:
+		return manager.atlas.GlyphRangesDefault()
+	}
+}
+
+// glyphRangeEntrySize returns the size of the code points in the glyph ranges of imgui, which is two or four bytes,
+// depending on how imgui was compiled. It is found from the default ranges, which start with the range 0x0020-0x00FF.
+func (manager *Manager) glyphRangeEntrySize() int {
+	ranges := manager.atlas.GlyphRangesDefault()
+	entry := *(*unsafe.Pointer)(unsafe.Pointer(&ranges))
+	const bytesPerUint16 = 2
+	if *(*uint16)(unsafe.Add(entry, bytesPerUint16)) == 0x00FF {
+		return bytesPerUint16
+	}
+	const bytesPerUint32 = 4
+	return bytesPerUint32
+}
+
+// glyphRangeList reads the ranges as imgui stores them, which is a list of pairs of code points of the given size,
+// terminated by zero.
+func glyphRangeList(ranges imgui.GlyphRanges, entrySize int) []GlyphRange {
+	if ranges == imgui.EmptyGlyphRanges {
+		return nil
+	}
+	codePoint := func(entry unsafe.Pointer) rune {
+		const bytesPerUint32 = 4
+		if entrySize == bytesPerUint32 {
+			return rune(*(*uint32)(entry))
+		}
+		return rune(*(*uint16)(entry))
+	}
+	var list []GlyphRange
+	entry := *(*unsafe.Pointer)(unsafe.Pointer(&ranges))
+	for codePoint(entry) != 0 {
+		list = append(list, GlyphRange{
+			From: codePoint(entry),
+			To:   codePoint(unsafe.Add(entry, entrySize)),
+		})
+		entry = unsafe.Add(entry, 2*entrySize)
+	}
+	return list
+}
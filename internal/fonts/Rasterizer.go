@@ -0,0 +1,253 @@
+package fonts
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"math"
+	"unsafe"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/font/opentype"
+	"github.com/jetsetilly/imgui-go/v5"
+	"golang.org/x/image/vector"
+)
+
+// RasterizerOptions make the manager rasterize the glyphs of a font in Go, from the outlines of
+// github.com/go-text/typesetting with the rasterizer of golang.org/x/image/vector, instead of with the rasterizer of imgui.
+// This gives the same glyphs on all platforms and renderers, and allows to select an instance of a variable font.
+//
+// imgui still packs the glyphs into the atlas, and positions and advances them, with the metrics of the default
+// instance of the font. Manager.Build() then draws the glyphs into their areas, aligned to the origin that imgui
+// placed them at. The glyphs of the instance keep the advances of the default instance, and they must fit into
+// the bounds of the default glyphs: Build() fails with ErrVariationExceedsGlyph for instances that extend beyond
+// them, which heavier weights typically do.
+//
+// The outlines are not hinted. go-text provides them as designed, and does not run the hinting instructions of fonts.
+type RasterizerOptions struct {
+	// Variations select the instance of a variable font. Axes that are not listed keep their default value.
+	// They are ignored for fonts that are not variable.
+	Variations []Variation
+	// Gamma is applied to the coverage of the glyphs. Values above one make the glyphs appear heavier,
+	// values below one make them lighter. Zero is treated as one.
+	Gamma float32
+}
+
+// Variation is the value of an axis of a variable font, in the units of its design space.
+type Variation struct {
+	// Axis is the tag of the axis, with four characters, such as "wght" for the weight or "wdth" for the width.
+	Axis  string
+	Value float32
+}
+
+// addedFont is a font that was added to the atlas, in the order in which it was added.
+type addedFont struct {
+	path   string
+	target imgui.Font
+	data   []byte
+	size   float32
+	ranges []GlyphRange
+	// options are nil for fonts that imgui rasterizes.
+	options *RasterizerOptions
+}
+
+// Build builds the font atlas, and draws the glyphs of the fonts with RasterizerOptions into it.
+// It has to be called after fonts have been added, and before the renderer rebuilds its font texture.
+// Without fonts that use the Go rasterizer, it only builds the atlas.
+func (manager *Manager) Build() error {
+	if !manager.atlas.Build() {
+		return ErrAtlasNotBuilt
+	}
+	rasterized := false
+	for _, added := range manager.added {
+		rasterized = rasterized || (added.options != nil)
+	}
+	if !rasterized {
+		return nil
+	}
+
+	data := manager.atlas.TextureDataAlpha8()
+	atlas := &image.Alpha{
+		Pix:    unsafe.Slice((*uint8)(data.Pixels), data.Width*data.Height),
+		Stride: data.Width,
+		Rect:   image.Rect(0, 0, data.Width, data.Height),
+	}
+
+	// As with imgui, the glyph of a code point comes from the first font that was merged into the same font and has it.
+	// Glyphs of fonts that imgui rasterizes are therefore recorded as well, so that later fonts do not draw over them.
+	drawn := make(map[imgui.Font]map[rune]bool)
+	for _, added := range manager.added {
+		if drawn[added.target] == nil {
+			drawn[added.target] = make(map[rune]bool)
+		}
+		var err error
+		if added.options != nil {
+			err = added.draw(atlas, drawn[added.target])
+		} else {
+			added.cover(drawn[added.target])
+		}
+		if err != nil {
+			return fmt.Errorf("failed to draw font %q: %w", added.path, err)
+		}
+	}
+	return nil
+}
+
+// cover records the code points of the ranges that the font has glyphs for.
+// Fonts that go-text can not read are assumed to have glyphs for all of their ranges.
+func (added addedFont) cover(drawn map[rune]bool) {
+	face, err := font.ParseTTF(bytes.NewReader(added.data))
+	for _, glyphRange := range added.ranges {
+		for r := glyphRange.From; r <= glyphRange.To; r++ {
+			if err == nil {
+				if _, hasGlyph := face.NominalGlyph(r); !hasGlyph {
+					continue
+				}
+			}
+			drawn[r] = true
+		}
+	}
+}
+
+// draw draws the glyphs of the font into the areas of the atlas that imgui reserved for them.
+func (added addedFont) draw(atlas *image.Alpha, drawn map[rune]bool) error {
+	// The default instance places the glyphs as imgui does, the selected instance provides their outlines.
+	defaultFace, err := font.ParseTTF(bytes.NewReader(added.data))
+	if err != nil {
+		return err
+	}
+	face := font.NewFace(defaultFace.Font)
+	face.SetVariations(fontVariations(added.options.Variations))
+
+	// imgui sizes fonts by the distance from their ascender to their descender, not by their em square.
+	scale := added.size / float32(defaultFace.Upem())
+	if extents, hasExtents := defaultFace.FontHExtents(); hasExtents && (extents.Ascender > extents.Descender) {
+		scale = added.size / (extents.Ascender - extents.Descender)
+	}
+
+	coverage := gammaTable(added.options.Gamma)
+	atlasSize := atlas.Bounds().Size()
+	var rasterizer vector.Rasterizer
+	for _, glyphRange := range added.ranges {
+		for r := glyphRange.From; r <= glyphRange.To; r++ {
+			if drawn[r] {
+				continue
+			}
+			id, hasGlyph := defaultFace.NominalGlyph(r)
+			if !hasGlyph {
+				continue
+			}
+			drawn[r] = true
+
+			glyph := added.target.FindGlyph(r)
+			if (glyph.Codepoint() != int(r)) || !glyph.Visible() {
+				continue
+			}
+			area := image.Rect(
+				int(math.Round(float64(glyph.U0()*float32(atlasSize.X)))),
+				int(math.Round(float64(glyph.V0()*float32(atlasSize.Y)))),
+				int(math.Round(float64(glyph.U1()*float32(atlasSize.X)))),
+				int(math.Round(float64(glyph.V1()*float32(atlasSize.Y))))).Intersect(atlas.Bounds())
+			extents, hasExtents := defaultFace.GlyphExtents(id)
+			defaultOutline, hasDefaultOutline := defaultFace.GlyphDataOutline(id)
+			outline, hasOutline := face.GlyphDataOutline(id)
+			if area.Empty() || !hasExtents || !hasDefaultOutline || !hasOutline {
+				continue
+			}
+			// The area only holds the pixels of the default glyph, the instance would be cut off at its bounds.
+			if !outlinePixels(outline, scale).In(outlinePixels(defaultOutline, scale)) {
+				return fmt.Errorf("%w: %U", ErrVariationExceedsGlyph, r)
+			}
+
+			// imgui places the top left corner of the area at the pixel that contains the top left corner of the
+			// bounding box of the default glyph, relative to the origin of the glyph.
+			origin := imgui.Vec2{
+				X: -float32(math.Floor(float64(extents.XBearing * scale))),
+				Y: -float32(math.Floor(float64(-extents.YBearing * scale))),
+			}
+			DrawOutline(&rasterizer, outline, scale, origin, area.Size())
+			mask := image.NewAlpha(image.Rectangle{Max: area.Size()})
+			rasterizer.Draw(mask, mask.Bounds(), image.Opaque, image.Point{})
+			for y := area.Min.Y; y < area.Max.Y; y++ {
+				for x := area.Min.X; x < area.Max.X; x++ {
+					atlas.Pix[atlas.PixOffset(x, y)] = coverage[mask.AlphaAt(x-area.Min.X, y-area.Min.Y).A]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// DrawOutline adds the outline to the rasterizer, reset to the given size. The outline is scaled from font units
+// to pixels, and its origin is placed at the given position. Font units grow upwards, pixels downwards.
+func DrawOutline(rasterizer *vector.Rasterizer, outline font.GlyphOutline, scale float32, origin imgui.Vec2, size image.Point) {
+	rasterizer.Reset(size.X, size.Y)
+	toPixels := func(point opentype.SegmentPoint) (float32, float32) {
+		return origin.X + point.X*scale, origin.Y - point.Y*scale
+	}
+	for i, segment := range outline.Segments {
+		switch segment.Op {
+		case opentype.SegmentOpMoveTo:
+			if i > 0 {
+				rasterizer.ClosePath()
+			}
+			rasterizer.MoveTo(toPixels(segment.Args[0]))
+		case opentype.SegmentOpLineTo:
+			rasterizer.LineTo(toPixels(segment.Args[0]))
+		case opentype.SegmentOpQuadTo:
+			bx, by := toPixels(segment.Args[0])
+			cx, cy := toPixels(segment.Args[1])
+			rasterizer.QuadTo(bx, by, cx, cy)
+		case opentype.SegmentOpCubeTo:
+			bx, by := toPixels(segment.Args[0])
+			cx, cy := toPixels(segment.Args[1])
+			dx, dy := toPixels(segment.Args[2])
+			rasterizer.CubeTo(bx, by, cx, cy, dx, dy)
+		}
+	}
+	if len(outline.Segments) > 0 {
+		rasterizer.ClosePath()
+	}
+}
+
+// outlinePixels returns the pixels that the control points of the outline cover, relative to its origin.
+// The outline is scaled from font units to pixels. Font units grow upwards, pixels downwards.
+func outlinePixels(outline font.GlyphOutline, scale float32) image.Rectangle {
+	if len(outline.Segments) == 0 {
+		return image.Rectangle{}
+	}
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := -minX, -minY
+	for i := range outline.Segments {
+		for _, point := range outline.Segments[i].ArgsSlice() {
+			minX = min(minX, point.X*scale)
+			maxX = max(maxX, point.X*scale)
+			minY = min(minY, -point.Y*scale)
+			maxY = max(maxY, -point.Y*scale)
+		}
+	}
+	return image.Rect(
+		int(math.Floor(float64(minX))), int(math.Floor(float64(minY))),
+		int(math.Ceil(float64(maxX))), int(math.Ceil(float64(maxY))))
+}
+
+// fontVariations converts the variations to the ones of go-text. The axes must have been validated.
+func fontVariations(variations []Variation) []font.Variation {
+	converted := make([]font.Variation, 0, len(variations))
+	for _, variation := range variations {
+		converted = append(converted, font.Variation{Tag: opentype.MustNewTag(variation.Axis), Value: variation.Value})
+	}
+	return converted
+}
+
+// gammaTable maps the coverage of the rasterizer to the coverage in the atlas.
+func gammaTable(gamma float32) [256]uint8 {
+	if gamma <= 0 {
+		gamma = 1
+	}
+	var table [256]uint8
+	for i := range table {
+		table[i] = uint8(math.Round(math.Pow(float64(i)/0xFF, 1/float64(gamma)) * 0xFF))
+	}
+	return table
+}
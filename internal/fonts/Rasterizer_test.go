@@ -0,0 +1,99 @@
+package fonts
+
+import (
+	"image"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/font/opentype"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestGammaTable(t *testing.T) {
+	tests := []struct {
+		name  string
+		gamma float32
+		want  map[int]uint8
+	}{
+		{name: "default", gamma: 0, want: map[int]uint8{0: 0, 0x40: 0x40, 0x80: 0x80, 0xFF: 0xFF}},
+		{name: "linear", gamma: 1, want: map[int]uint8{0: 0, 0x40: 0x40, 0x80: 0x80, 0xFF: 0xFF}},
+		{name: "heavier", gamma: 2, want: map[int]uint8{0: 0, 0x40: 0x80, 0xFF: 0xFF}},
+		{name: "lighter", gamma: 0.5, want: map[int]uint8{0: 0, 0x80: 0x40, 0xFF: 0xFF}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := gammaTable(test.gamma)
+			for coverage, want := range test.want {
+				if got := int(table[coverage]); (got < int(want)-1) || (got > int(want)+1) {
+					t.Errorf("coverage %#x maps to %#x, want %#x", coverage, got, want)
+				}
+			}
+			for i := 1; i < len(table); i++ {
+				if table[i] < table[i-1] {
+					t.Fatalf("table decreases at %#x", i)
+				}
+			}
+		})
+	}
+}
+
+// glyphRangesOf returns the ranges of imgui that point to the given code points.
+func glyphRangesOf(entries unsafe.Pointer) imgui.GlyphRanges {
+	return *(*imgui.GlyphRanges)(unsafe.Pointer(&entries))
+}
+
+func TestGlyphRangeList(t *testing.T) {
+	want := []GlyphRange{{From: 0x20, To: 0xFF}, {From: 0x0E00, To: 0x0E7F}, {From: 0x1F600, To: 0x1F64F}}
+
+	entries32 := []uint32{0x20, 0xFF, 0x0E00, 0x0E7F, 0x1F600, 0x1F64F, 0}
+	list := glyphRangeList(glyphRangesOf(unsafe.Pointer(&entries32[0])), 4)
+	runtime.KeepAlive(entries32)
+	if len(list) != len(want) {
+		t.Fatalf("32 bit ranges are %v, want %v", list, want)
+	}
+	for i := range want {
+		if list[i] != want[i] {
+			t.Errorf("32 bit range %v is %v, want %v", i, list[i], want[i])
+		}
+	}
+
+	entries16 := []uint16{0x20, 0xFF, 0x0E00, 0x0E7F, 0}
+	list = glyphRangeList(glyphRangesOf(unsafe.Pointer(&entries16[0])), 2)
+	runtime.KeepAlive(entries16)
+	if len(list) != 2 {
+		t.Fatalf("16 bit ranges are %v, want %v", list, want[:2])
+	}
+	for i := range list {
+		if list[i] != want[i] {
+			t.Errorf("16 bit range %v is %v, want %v", i, list[i], want[i])
+		}
+	}
+
+	if list := glyphRangeList(imgui.EmptyGlyphRanges, 2); list != nil {
+		t.Errorf("empty ranges are %v", list)
+	}
+}
+
+func TestOutlinePixels(t *testing.T) {
+	segment := func(op opentype.SegmentOp, points ...opentype.SegmentPoint) opentype.Segment {
+		var segment opentype.Segment
+		segment.Op = op
+		copy(segment.Args[:], points)
+		return segment
+	}
+	outline := font.GlyphOutline{Segments: []opentype.Segment{
+		segment(opentype.SegmentOpMoveTo, opentype.SegmentPoint{X: 10, Y: -20}),
+		segment(opentype.SegmentOpLineTo, opentype.SegmentPoint{X: 100, Y: 0}),
+		segment(opentype.SegmentOpQuadTo, opentype.SegmentPoint{X: 55, Y: 710}, opentype.SegmentPoint{X: 10, Y: -20}),
+	}}
+
+	// Pixels grow downwards, and the control point of the curve counts as well.
+	if got, want := outlinePixels(outline, 0.1), image.Rect(1, -71, 10, 2); got != want {
+		t.Errorf("outline covers %v, want %v", got, want)
+	}
+	if got := outlinePixels(font.GlyphOutline{}, 0.1); !got.Empty() {
+		t.Errorf("empty outline covers %v", got)
+	}
+}
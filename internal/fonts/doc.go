@@ -0,0 +1,7 @@
+// Package fonts contains helpers for loading fonts into the imgui font atlas.
+// Fonts are read from TTF/OTF files, either from the local file system or any fs.FS (such as embed.FS),
+// and may be merged with each other, for example to add icons or glyphs of other scripts to a base font.
+// Glyphs are rasterized by imgui, or in Go from the outlines of github.com/go-text/typesetting, for consistent results
+// on all platforms and to select instances of variable fonts.
+// After fonts have been loaded, the renderer has to rebuild its font texture.
+package fonts
@@ -0,0 +1,25 @@
+package fonts
+
+// StringError describes a basic error with static information.
+type StringError string
+
+// Error returns the string itself.
+func (err StringError) Error() string {
+	return string(err)
+}
+
+const (
+	// ErrFontNotAdded is used in case imgui could not add a font to the atlas, for example if the file is not a valid font.
+	ErrFontNotAdded = StringError("font could not be added to atlas")
+	// ErrInvalidFontSize is used in case the size of a font is not a positive number of pixels.
+	ErrInvalidFontSize = StringError("font size must be positive")
+	// ErrNothingToMerge is used in case the first font to be added is a merged font.
+	ErrNothingToMerge = StringError("no font to merge into")
+	// ErrInvalidVariationAxis is used in case a variation of RasterizerOptions does not name an axis with four characters.
+	ErrInvalidVariationAxis = StringError("variation axis must have four characters")
+	// ErrVariationExceedsGlyph is used in case the instance that RasterizerOptions select has a glyph that extends
+	// beyond the glyph of the default instance, into which it is drawn.
+	ErrVariationExceedsGlyph = StringError("glyph of the variation extends beyond the glyph of the default instance")
+	// ErrAtlasNotBuilt is used in case imgui could not build the font atlas.
+	ErrAtlasNotBuilt = StringError("font atlas could not be built")
+)
@@ -0,0 +1,14 @@
+package platforms
+
+import (
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// setDisplayState forwards the size of the display and the ratio of framebuffer pixels per display unit to imgui IO.
+// The renderers take both from the draw data, which copies them from imgui IO.
+func setDisplayState(io imgui.IO, displaySize [2]float32, framebufferSize [2]float32) {
+	io.SetDisplaySize(imgui.Vec2{X: displaySize[0], Y: displaySize[1]})
+	if (displaySize[0] > 0) && (displaySize[1] > 0) {
+		io.SetDisplayFrameBufferScale(imgui.Vec2{X: framebufferSize[0] / displaySize[0], Y: framebufferSize[1] / displaySize[1]})
+	}
+}
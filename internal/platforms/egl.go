@@ -0,0 +1,8 @@
+//go:build egl
+// +build egl
+
+package platforms
+
+// useEGL is set if the OpenGL bindings resolve their functions through EGL, which requires the
+// contexts to be created through EGL as well.
+const useEGL = true
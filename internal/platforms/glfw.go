@@ -7,8 +7,9 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"time"
 
-	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/go-gl/glfw/v3.3/glfw"
 	"github.com/jetsetilly/imgui-go/v5"
 )
 
@@ -17,11 +18,13 @@ type GLFWClientAPI string
 
 // This is a list of GLFWClientAPI constants.
 const (
-	GLFWClientAPIOpenGL2 GLFWClientAPI = "OpenGL2"
-	GLFWClientAPIOpenGL3 GLFWClientAPI = "OpenGL3"
+	GLFWClientAPIOpenGL2   GLFWClientAPI = "OpenGL2"
+	GLFWClientAPIOpenGL3   GLFWClientAPI = "OpenGL3"
+	GLFWClientAPIOpenGLES2 GLFWClientAPI = "OpenGLES2"
+	GLFWClientAPIOpenGLES3 GLFWClientAPI = "OpenGLES3"
 )
 
-// GLFW implements a platform based on github.com/go-gl/glfw (v3.2).
+// GLFW implements a platform based on github.com/go-gl/glfw (v3.3).
 type GLFW struct {
 	imguiIO imgui.IO
 
@@ -29,10 +32,16 @@ type GLFW struct {
 
 	time             float64
 	mouseJustPressed [3]bool
+	eventsDispatched bool
 }
 
 // NewGLFW attempts to initialize a GLFW context.
 func NewGLFW(io imgui.IO, clientAPI GLFWClientAPI) (*GLFW, error) {
+	return NewGLFWV(io, clientAPI, Options{})
+}
+
+// NewGLFWV attempts to initialize a GLFW context with the given options.
+func NewGLFWV(io imgui.IO, clientAPI GLFWClientAPI, options Options) (*GLFW, error) {
 	runtime.LockOSThread()
 
 	err := glfw.Init()
@@ -49,10 +58,33 @@ func NewGLFW(io imgui.IO, clientAPI GLFWClientAPI) (*GLFW, error) {
 		glfw.WindowHint(glfw.ContextVersionMinor, 2)
 		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
 		glfw.WindowHint(glfw.OpenGLForwardCompatible, 1)
+	case GLFWClientAPIOpenGLES2:
+		glfw.WindowHint(glfw.ClientAPI, glfw.OpenGLESAPI)
+		glfw.WindowHint(glfw.ContextVersionMajor, 2)
+		glfw.WindowHint(glfw.ContextVersionMinor, 0)
+	case GLFWClientAPIOpenGLES3:
+		glfw.WindowHint(glfw.ClientAPI, glfw.OpenGLESAPI)
+		glfw.WindowHint(glfw.ContextVersionMajor, 3)
+		glfw.WindowHint(glfw.ContextVersionMinor, 0)
 	default:
 		glfw.Terminate()
 		return nil, ErrUnsupportedClientAPI
 	}
+	if useEGL {
+		glfw.WindowHint(glfw.ContextCreationAPI, glfw.EGLContextAPI)
+	}
+	if options.Debug {
+		glfw.WindowHint(glfw.OpenGLDebugContext, glfw.True)
+	}
+	if options.SRGB {
+		glfw.WindowHint(glfw.SRGBCapable, glfw.True)
+	}
+	if options.Transparent {
+		glfw.WindowHint(glfw.TransparentFramebuffer, glfw.True)
+	}
+	if options.Borderless {
+		glfw.WindowHint(glfw.Decorated, glfw.False)
+	}
 
 	window, err := glfw.CreateWindow(windowWidth, windowHeight, "ImGui-Go GLFW+"+string(clientAPI)+" example", nil, nil)
 	if err != nil {
@@ -82,9 +114,20 @@ func (platform *GLFW) ShouldStop() bool {
 	return platform.window.ShouldClose()
 }
 
-// ProcessEvents handles all pending window events.
-func (platform *GLFW) ProcessEvents() {
-	glfw.PollEvents()
+// Focused returns true if the window has the input focus.
+func (platform *GLFW) Focused() bool {
+	return platform.window.GetAttrib(glfw.Focused) != 0
+}
+
+// ProcessEvents handles all pending window events. If there are none, it waits up to the given timeout for events to arrive.
+func (platform *GLFW) ProcessEvents(timeout time.Duration) bool {
+	platform.eventsDispatched = false
+	if timeout > 0 {
+		glfw.WaitEventsTimeout(timeout.Seconds())
+	} else {
+		glfw.PollEvents()
+	}
+	return platform.eventsDispatched
 }
 
 // DisplaySize returns the dimension of the display.
@@ -101,9 +144,8 @@ func (platform *GLFW) FramebufferSize() [2]float32 {
 
 // NewFrame marks the begin of a render pass. It forwards all current state to imgui IO.
 func (platform *GLFW) NewFrame() {
-	// Setup display size (every frame to accommodate for window resizing)
-	displaySize := platform.DisplaySize()
-	platform.imguiIO.SetDisplaySize(imgui.Vec2{X: displaySize[0], Y: displaySize[1]})
+	// Setup display size and framebuffer scale (every frame to accommodate for window resizing)
+	setDisplayState(platform.imguiIO, platform.DisplaySize(), platform.FramebufferSize())
 
 	// Setup time step
 	currentTime := glfw.GetTime()
@@ -113,7 +155,7 @@ func (platform *GLFW) NewFrame() {
 	platform.time = currentTime
 
 	// Setup inputs
-	if platform.window.GetAttrib(glfw.Focused) != 0 {
+	if platform.Focused() {
 		x, y := platform.window.GetCursorPos()
 		platform.imguiIO.SetMousePosition(imgui.Vec2{X: float32(x), Y: float32(y)})
 	} else {
@@ -137,6 +179,10 @@ func (platform *GLFW) installCallbacks() {
 	platform.window.SetScrollCallback(platform.mouseScrollChange)
 	platform.window.SetKeyCallback(platform.keyChange)
 	platform.window.SetCharCallback(platform.charChange)
+	platform.window.SetCursorPosCallback(platform.cursorPosChange)
+	platform.window.SetCursorEnterCallback(platform.cursorEnterChange)
+	platform.window.SetFramebufferSizeCallback(platform.framebufferSizeChange)
+	platform.window.SetFocusCallback(platform.focusChange)
 }
 
 var glfwButtonIndexByID = map[glfw.MouseButton]int{
@@ -152,6 +198,7 @@ var glfwButtonIDByIndex = map[int]glfw.MouseButton{
 }
 
 func (platform *GLFW) mouseButtonChange(window *glfw.Window, rawButton glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+	platform.eventsDispatched = true
 	buttonIndex, known := glfwButtonIndexByID[rawButton]
 
 	if known && (action == glfw.Press) {
@@ -160,10 +207,12 @@ func (platform *GLFW) mouseButtonChange(window *glfw.Window, rawButton glfw.Mous
 }
 
 func (platform *GLFW) mouseScrollChange(window *glfw.Window, x, y float64) {
+	platform.eventsDispatched = true
 	platform.imguiIO.AddMouseWheelDelta(float32(x), float32(y))
 }
 
 func (platform *GLFW) keyChange(window *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
+	platform.eventsDispatched = true
 	k := glfwKeyEventToImguiKey(key, scancode)
 	if action == glfw.Press {
 		platform.imguiIO.AddKeyEvent(k, true)
@@ -427,12 +476,32 @@ func glfwKeyEventToImguiKey(key glfw.Key, scancode int) imgui.ImguiKey {
 }
 
 func (platform *GLFW) charChange(window *glfw.Window, char rune) {
+	platform.eventsDispatched = true
 	platform.imguiIO.AddInputCharacters(string(char))
 }
 
+// The following callbacks carry no state for imgui, which is polled in NewFrame() instead.
+// They only note that something happened, so that a new frame is rendered.
+
+func (platform *GLFW) cursorPosChange(window *glfw.Window, x, y float64) {
+	platform.eventsDispatched = true
+}
+
+func (platform *GLFW) cursorEnterChange(window *glfw.Window, entered bool) {
+	platform.eventsDispatched = true
+}
+
+func (platform *GLFW) framebufferSizeChange(window *glfw.Window, width, height int) {
+	platform.eventsDispatched = true
+}
+
+func (platform *GLFW) focusChange(window *glfw.Window, focused bool) {
+	platform.eventsDispatched = true
+}
+
 // ClipboardText returns the current clipboard text, if available.
 func (platform *GLFW) ClipboardText() (string, error) {
-	return platform.window.GetClipboardString()
+	return platform.window.GetClipboardString(), nil
 }
 
 // SetClipboardText sets the text as the current clipboard text.
@@ -0,0 +1,243 @@
+//go:build egl
+// +build egl
+
+package platforms
+
+// #cgo pkg-config: egl
+// #include <string.h>
+// #include <EGL/egl.h>
+// #include <EGL/eglext.h>
+//
+// #ifndef EGL_PLATFORM_SURFACELESS_MESA
+// #define EGL_PLATFORM_SURFACELESS_MESA 0x31DD
+// #endif
+//
+// // headlessDisplay prefers the surfaceless platform of Mesa, which requires neither a window system nor a GPU.
+// static EGLDisplay headlessDisplay() {
+//   const char *extensions = eglQueryString(EGL_NO_DISPLAY, EGL_EXTENSIONS);
+//   PFNEGLGETPLATFORMDISPLAYEXTPROC getPlatformDisplay =
+//     (PFNEGLGETPLATFORMDISPLAYEXTPROC)eglGetProcAddress("eglGetPlatformDisplayEXT");
+//   if ((extensions != NULL) && (strstr(extensions, "EGL_MESA_platform_surfaceless") != NULL) && (getPlatformDisplay != NULL)) {
+//     return getPlatformDisplay(EGL_PLATFORM_SURFACELESS_MESA, EGL_DEFAULT_DISPLAY, NULL);
+//   }
+//   return eglGetDisplay(EGL_DEFAULT_DISPLAY);
+// }
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"time"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// HeadlessClientAPI identifies the render system that shall be initialized.
+type HeadlessClientAPI string
+
+// This is a list of HeadlessClientAPI constants.
+const (
+	HeadlessClientAPIOpenGL2   HeadlessClientAPI = "OpenGL2"
+	HeadlessClientAPIOpenGL3   HeadlessClientAPI = "OpenGL3"
+	HeadlessClientAPIOpenGLES2 HeadlessClientAPI = "OpenGLES2"
+	HeadlessClientAPIOpenGLES3 HeadlessClientAPI = "OpenGLES3"
+)
+
+// headlessDeltaTime is the fixed time step of each frame, so that the output does not depend on the speed of the machine.
+const headlessDeltaTime = 1.0 / 60.0
+
+// Headless implements a platform without window system, based on an EGL pbuffer surface.
+// It is meant for tests and continuous integration, where the renderers run with Mesa's llvmpipe
+// or any other driver that provides EGL. There is no input, and every frame advances the time by 1/60 second.
+//
+// The OpenGL bindings must resolve their functions through EGL as well, so this platform is only available
+// with the build tag "egl".
+//
+// The context is current on the OS thread that created the platform. NewHeadless() locks the calling goroutine
+// to that thread, as does NewHeadlessV(). Dispose() unlocks it, so both must be called from the same goroutine.
+type Headless struct {
+	imguiIO imgui.IO
+
+	display C.EGLDisplay
+	surface C.EGLSurface
+	context C.EGLContext
+
+	width      int
+	height     int
+	frameLimit int
+	frames     int
+	shouldStop bool
+	clipboard  string
+}
+
+// NewHeadless attempts to initialize an EGL context with a pbuffer surface of the given size.
+func NewHeadless(io imgui.IO, clientAPI HeadlessClientAPI, width, height int) (*Headless, error) {
+	return NewHeadlessV(io, clientAPI, width, height, Options{})
+}
+
+// NewHeadlessV attempts to initialize an EGL context with a pbuffer surface of the given size, and the given options.
+func NewHeadlessV(io imgui.IO, clientAPI HeadlessClientAPI, width, height int, options Options) (*Headless, error) {
+	var api C.EGLenum
+	var renderableType C.EGLint
+	contextAttribs := []C.EGLint{}
+	switch clientAPI {
+	case HeadlessClientAPIOpenGL2:
+		api, renderableType = C.EGL_OPENGL_API, C.EGL_OPENGL_BIT
+		contextAttribs = append(contextAttribs, C.EGL_CONTEXT_MAJOR_VERSION, 2, C.EGL_CONTEXT_MINOR_VERSION, 1)
+	case HeadlessClientAPIOpenGL3:
+		api, renderableType = C.EGL_OPENGL_API, C.EGL_OPENGL_BIT
+		contextAttribs = append(contextAttribs, C.EGL_CONTEXT_MAJOR_VERSION, 3, C.EGL_CONTEXT_MINOR_VERSION, 2,
+			C.EGL_CONTEXT_OPENGL_PROFILE_MASK, C.EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT,
+			C.EGL_CONTEXT_OPENGL_FORWARD_COMPATIBLE, C.EGL_TRUE)
+	case HeadlessClientAPIOpenGLES2:
+		api, renderableType = C.EGL_OPENGL_ES_API, C.EGL_OPENGL_ES2_BIT
+		contextAttribs = append(contextAttribs, C.EGL_CONTEXT_MAJOR_VERSION, 2)
+	case HeadlessClientAPIOpenGLES3:
+		api, renderableType = C.EGL_OPENGL_ES_API, C.EGL_OPENGL_ES3_BIT
+		contextAttribs = append(contextAttribs, C.EGL_CONTEXT_MAJOR_VERSION, 3)
+	default:
+		return nil, ErrUnsupportedClientAPI
+	}
+	if options.Debug {
+		contextAttribs = append(contextAttribs, C.EGL_CONTEXT_OPENGL_DEBUG, C.EGL_TRUE)
+	}
+	contextAttribs = append(contextAttribs, C.EGL_NONE)
+
+	// The bound API and the current context belong to the calling thread.
+	runtime.LockOSThread()
+
+	platform := &Headless{
+		imguiIO: io,
+		width:   width,
+		height:  height,
+	}
+	fail := func(message string) (*Headless, error) {
+		err := eglError(message)
+		platform.Dispose()
+		return nil, err
+	}
+
+	platform.display = C.headlessDisplay()
+	if platform.display == C.EGLDisplay(C.EGL_NO_DISPLAY) {
+		runtime.UnlockOSThread()
+		return nil, eglError("failed to get EGL display")
+	}
+	if C.eglInitialize(platform.display, nil, nil) == C.EGL_FALSE {
+		return fail("failed to initialize EGL")
+	}
+	if C.eglBindAPI(api) == C.EGL_FALSE {
+		return fail("failed to bind API")
+	}
+
+	configAttribs := []C.EGLint{
+		C.EGL_SURFACE_TYPE, C.EGL_PBUFFER_BIT,
+		C.EGL_RENDERABLE_TYPE, renderableType,
+		C.EGL_RED_SIZE, 8,
+		C.EGL_GREEN_SIZE, 8,
+		C.EGL_BLUE_SIZE, 8,
+		C.EGL_ALPHA_SIZE, 8,
+		C.EGL_NONE,
+	}
+	var config C.EGLConfig
+	var configCount C.EGLint
+	if (C.eglChooseConfig(platform.display, &configAttribs[0], &config, 1, &configCount) == C.EGL_FALSE) || (configCount == 0) {
+		return fail("no matching EGL config")
+	}
+
+	surfaceAttribs := []C.EGLint{
+		C.EGL_WIDTH, C.EGLint(width),
+		C.EGL_HEIGHT, C.EGLint(height),
+		C.EGL_NONE,
+	}
+	platform.surface = C.eglCreatePbufferSurface(platform.display, config, &surfaceAttribs[0])
+	if platform.surface == C.EGLSurface(C.EGL_NO_SURFACE) {
+		return fail("failed to create pbuffer surface")
+	}
+
+	platform.context = C.eglCreateContext(platform.display, config, C.EGLContext(C.EGL_NO_CONTEXT), &contextAttribs[0])
+	if platform.context == C.EGLContext(C.EGL_NO_CONTEXT) {
+		return fail("failed to create OpenGL context")
+	}
+	if C.eglMakeCurrent(platform.display, platform.surface, platform.surface, platform.context) == C.EGL_FALSE {
+		return fail("failed to set current OpenGL context")
+	}
+
+	return platform, nil
+}
+
+func eglError(message string) error {
+	return fmt.Errorf("%s: EGL error 0x%X", message, int(C.eglGetError()))
+}
+
+// Dispose cleans up the resources, and unlocks the goroutine from its OS thread.
+func (platform *Headless) Dispose() {
+	if platform.display == C.EGLDisplay(C.EGL_NO_DISPLAY) {
+		return
+	}
+	C.eglMakeCurrent(platform.display, C.EGLSurface(C.EGL_NO_SURFACE), C.EGLSurface(C.EGL_NO_SURFACE), C.EGLContext(C.EGL_NO_CONTEXT))
+	if platform.context != C.EGLContext(C.EGL_NO_CONTEXT) {
+		C.eglDestroyContext(platform.display, platform.context)
+		platform.context = C.EGLContext(C.EGL_NO_CONTEXT)
+	}
+	if platform.surface != C.EGLSurface(C.EGL_NO_SURFACE) {
+		C.eglDestroySurface(platform.display, platform.surface)
+		platform.surface = C.EGLSurface(C.EGL_NO_SURFACE)
+	}
+	C.eglTerminate(platform.display)
+	platform.display = C.EGLDisplay(C.EGL_NO_DISPLAY)
+	runtime.UnlockOSThread()
+}
+
+// SetFrameLimit makes ShouldStop() return true after the given number of frames. Zero removes the limit.
+func (platform *Headless) SetFrameLimit(frames int) {
+	platform.frameLimit = frames
+}
+
+// Stop makes ShouldStop() return true.
+func (platform *Headless) Stop() {
+	platform.shouldStop = true
+}
+
+// ShouldStop returns true if Stop() was called, or the frame limit is reached.
+func (platform *Headless) ShouldStop() bool {
+	return platform.shouldStop || ((platform.frameLimit > 0) && (platform.frames >= platform.frameLimit))
+}
+
+// ProcessEvents returns immediately, as there are no events. It never waits, as no events will arrive.
+func (platform *Headless) ProcessEvents(timeout time.Duration) bool {
+	return false
+}
+
+// DisplaySize returns the dimension of the display, which is the size of the surface.
+func (platform *Headless) DisplaySize() [2]float32 {
+	return [2]float32{float32(platform.width), float32(platform.height)}
+}
+
+// FramebufferSize returns the dimension of the framebuffer, which is the size of the surface.
+func (platform *Headless) FramebufferSize() [2]float32 {
+	return platform.DisplaySize()
+}
+
+// NewFrame marks the begin of a render pass. It forwards the display size and the fixed time step to imgui IO.
+func (platform *Headless) NewFrame() {
+	setDisplayState(platform.imguiIO, platform.DisplaySize(), platform.FramebufferSize())
+	platform.imguiIO.SetDeltaTime(headlessDeltaTime)
+	platform.imguiIO.SetMousePosition(imgui.Vec2{X: -math.MaxFloat32, Y: -math.MaxFloat32})
+}
+
+// PostRender completes the frame. The content of the surface remains available until the next frame.
+func (platform *Headless) PostRender() {
+	C.eglSwapBuffers(platform.display, platform.surface)
+	platform.frames++
+}
+
+// ClipboardText returns the text last set with SetClipboardText().
+func (platform *Headless) ClipboardText() (string, error) {
+	return platform.clipboard, nil
+}
+
+// SetClipboardText keeps the text for ClipboardText(). It does not leave the process.
+func (platform *Headless) SetClipboardText(text string) {
+	platform.clipboard = text
+}
@@ -0,0 +1,21 @@
+package platforms
+
+// Options request optional features of the window and OpenGL context a platform creates.
+// The zero value requests none of them.
+type Options struct {
+	// Debug requests a debug context. Debug contexts report errors and performance hints, which the
+	// renderers can forward to a logger. Drivers may provide debug output without a debug context, but
+	// typically report much less.
+	Debug bool
+	// SRGB requests a default framebuffer that can encode linear colors to sRGB on write, which renderers use for
+	// blending in linear space, see renderers.OpenGL3.SetSRGB(). The framebuffer stores colors as before, unless
+	// the renderer enables the encoding. The headless platform ignores this option.
+	SRGB bool
+	// Transparent requests a window whose framebuffer has an alpha channel, which is composited onto the desktop.
+	// Compositors expect premultiplied alpha, which renderers write once their SetTransparent() was called, so that
+	// the clear color and the widgets determine what shows through. Whether the desktop actually shows through
+	// depends on the window system and its compositor.
+	Transparent bool
+	// Borderless requests a window without decorations, such as a title bar and borders.
+	Borderless bool
+}
@@ -6,6 +6,7 @@ package platforms
 import (
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/jetsetilly/imgui-go/v5"
 	"github.com/veandco/go-sdl2/sdl"
@@ -16,8 +17,10 @@ type SDLClientAPI string
 
 // This is a list of SDLClientAPI constants.
 const (
-	SDLClientAPIOpenGL2 SDLClientAPI = "OpenGL2"
-	SDLClientAPIOpenGL3 SDLClientAPI = "OpenGL3"
+	SDLClientAPIOpenGL2   SDLClientAPI = "OpenGL2"
+	SDLClientAPIOpenGL3   SDLClientAPI = "OpenGL3"
+	SDLClientAPIOpenGLES2 SDLClientAPI = "OpenGLES2"
+	SDLClientAPIOpenGLES3 SDLClientAPI = "OpenGLES3"
 )
 
 // SDL implements a platform based on github.com/veandco/go-sdl2 (v2).
@@ -33,15 +36,39 @@ type SDL struct {
 
 // NewSDL attempts to initialize an SDL context.
 func NewSDL(io imgui.IO, clientAPI SDLClientAPI) (*SDL, error) {
+	return NewSDLV(io, clientAPI, Options{})
+}
+
+// NewSDLV attempts to initialize an SDL context with the given options.
+func NewSDLV(io imgui.IO, clientAPI SDLClientAPI, options Options) (*SDL, error) {
 	runtime.LockOSThread()
 
+	if useEGL {
+		_ = sdl.SetHint(sdl.HINT_VIDEO_X11_FORCE_EGL, "1")
+	}
+
 	err := sdl.Init(sdl.INIT_VIDEO)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize SDL2: %w", err)
 	}
 
+	// The pixel format of the window, including whether it is sRGB-capable and has an alpha channel,
+	// is chosen when the window is created.
+	if options.SRGB {
+		_ = sdl.GLSetAttribute(sdl.GL_FRAMEBUFFER_SRGB_CAPABLE, 1)
+	}
+	// SDL2 has no flag for transparent windows. Some video drivers make windows transparent if their framebuffer
+	// has an alpha channel, and a compositor is running.
+	if options.Transparent {
+		_ = sdl.GLSetAttribute(sdl.GL_ALPHA_SIZE, 8)
+	}
+	var windowFlags uint32 = sdl.WINDOW_OPENGL
+	if options.Borderless {
+		windowFlags |= sdl.WINDOW_BORDERLESS
+	}
+
 	window, err := sdl.CreateWindow("ImGui-Go SDL2+"+string(clientAPI)+" example",
-		sdl.WINDOWPOS_CENTERED, sdl.WINDOWPOS_CENTERED, windowWidth, windowHeight, sdl.WINDOW_OPENGL)
+		sdl.WINDOWPOS_CENTERED, sdl.WINDOWPOS_CENTERED, windowWidth, windowHeight, windowFlags)
 	if err != nil {
 		sdl.Quit()
 		return nil, fmt.Errorf("failed to create window: %w", err)
@@ -52,6 +79,10 @@ func NewSDL(io imgui.IO, clientAPI SDLClientAPI) (*SDL, error) {
 		window:  window,
 	}
 
+	contextFlags := 0
+	if options.Debug {
+		contextFlags |= sdl.GL_CONTEXT_DEBUG_FLAG
+	}
 	switch clientAPI {
 	case SDLClientAPIOpenGL2:
 		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MAJOR_VERSION, 2)
@@ -59,12 +90,21 @@ func NewSDL(io imgui.IO, clientAPI SDLClientAPI) (*SDL, error) {
 	case SDLClientAPIOpenGL3:
 		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MAJOR_VERSION, 3)
 		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MINOR_VERSION, 2)
-		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_FLAGS, sdl.GL_CONTEXT_FORWARD_COMPATIBLE_FLAG)
+		contextFlags |= sdl.GL_CONTEXT_FORWARD_COMPATIBLE_FLAG
 		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_PROFILE_MASK, sdl.GL_CONTEXT_PROFILE_CORE)
+	case SDLClientAPIOpenGLES2:
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MAJOR_VERSION, 2)
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MINOR_VERSION, 0)
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_PROFILE_MASK, sdl.GL_CONTEXT_PROFILE_ES)
+	case SDLClientAPIOpenGLES3:
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MAJOR_VERSION, 3)
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_MINOR_VERSION, 0)
+		_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_PROFILE_MASK, sdl.GL_CONTEXT_PROFILE_ES)
 	default:
 		platform.Dispose()
 		return nil, ErrUnsupportedClientAPI
 	}
+	_ = sdl.GLSetAttribute(sdl.GL_CONTEXT_FLAGS, contextFlags)
 	_ = sdl.GLSetAttribute(sdl.GL_DOUBLEBUFFER, 1)
 	_ = sdl.GLSetAttribute(sdl.GL_DEPTH_SIZE, 24)
 	_ = sdl.GLSetAttribute(sdl.GL_STENCIL_SIZE, 8)
@@ -99,11 +139,25 @@ func (platform *SDL) ShouldStop() bool {
 	return platform.shouldStop
 }
 
-// ProcessEvents handles all pending window events.
-func (platform *SDL) ProcessEvents() {
+// Focused returns true if the window has the input focus.
+func (platform *SDL) Focused() bool {
+	return (platform.window.GetFlags() & sdl.WINDOW_INPUT_FOCUS) != 0
+}
+
+// ProcessEvents handles all pending window events. If there are none, it waits up to the given timeout for events to arrive.
+func (platform *SDL) ProcessEvents(timeout time.Duration) bool {
+	dispatched := false
+	if timeout > 0 {
+		if event := sdl.WaitEventTimeout(int(timeout / time.Millisecond)); event != nil {
+			platform.processEvent(event)
+			dispatched = true
+		}
+	}
 	for event := sdl.PollEvent(); event != nil; event = sdl.PollEvent() {
 		platform.processEvent(event)
+		dispatched = true
 	}
+	return dispatched
 }
 
 // DisplaySize returns the dimension of the display.
@@ -120,9 +174,8 @@ func (platform *SDL) FramebufferSize() [2]float32 {
 
 // NewFrame marks the begin of a render pass. It forwards all current state to imgui.CurrentIO().
 func (platform *SDL) NewFrame() {
-	// Setup display size (every frame to accommodate for window resizing)
-	displaySize := platform.DisplaySize()
-	platform.imguiIO.SetDisplaySize(imgui.Vec2{X: displaySize[0], Y: displaySize[1]})
+	// Setup display size and framebuffer scale (every frame to accommodate for window resizing)
+	setDisplayState(platform.imguiIO, platform.DisplaySize(), platform.FramebufferSize())
 
 	// Setup time step (we don't use SDL_GetTicks() because it is using millisecond resolution)
 	frequency := sdl.GetPerformanceFrequency()
@@ -0,0 +1,161 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// streamBufferMinCapacity is the size, in bytes, with which buffers are initially allocated.
+const streamBufferMinCapacity = 64 * 1024
+
+// streamBuffer is a GL buffer object with tracked capacity, that grows geometrically.
+type streamBuffer struct {
+	fn       *glFunctions
+	target   uint32
+	handle   uint32
+	capacity int
+}
+
+func (buffer *streamBuffer) create(fn *glFunctions, target uint32) {
+	buffer.fn = fn
+	buffer.target = target
+	buffer.capacity = 0
+	fn.GenBuffers(1, &buffer.handle)
+}
+
+func (buffer *streamBuffer) delete() {
+	if buffer.handle != 0 {
+		buffer.fn.DeleteBuffers(1, &buffer.handle)
+	}
+	buffer.handle = 0
+	buffer.capacity = 0
+}
+
+// reserve binds the buffer and ensures that it can hold the given number of bytes.
+// With the orphaning strategy, or if the buffer has to grow, the storage is re-specified and the previous content is lost.
+func (buffer *streamBuffer) reserve(size int, strategy BufferStrategy) {
+	buffer.fn.BindBuffer(buffer.target, buffer.handle)
+	if size > buffer.capacity {
+		capacity := buffer.capacity
+		if capacity < streamBufferMinCapacity {
+			capacity = streamBufferMinCapacity
+		}
+		for capacity < size {
+			capacity *= 2
+		}
+		buffer.capacity = capacity
+		buffer.fn.BufferData(buffer.target, buffer.capacity, nil, glStreamDraw)
+	} else if strategy == BufferOrphan {
+		buffer.fn.BufferData(buffer.target, buffer.capacity, nil, glStreamDraw)
+	}
+}
+
+// drawListOffsets locates a command list within the shared buffers.
+type drawListOffsets struct {
+	baseVertex  int
+	indexOffset int
+}
+
+// drawListBuffers are the vertex buffer and the index buffer that all command lists of a frame are uploaded into.
+type drawListBuffers struct {
+	vertexBuffer streamBuffer
+	indexBuffer  streamBuffer
+	strategy     BufferStrategy
+	listOffsets  []drawListOffsets
+}
+
+func (buffers *drawListBuffers) create(fn *glFunctions) {
+	buffers.vertexBuffer.create(fn, glArrayBuffer)
+	buffers.indexBuffer.create(fn, glElementArrayBuffer)
+}
+
+func (buffers *drawListBuffers) delete() {
+	buffers.vertexBuffer.delete()
+	buffers.indexBuffer.delete()
+}
+
+// upload writes the vertices and indices of all given lists into the buffers, records the location of each list
+// in listOffsets, and adds the uploaded amounts to the stats, if given. The vertices and indices of each list are
+// the ones that vertices and indices return. A bound vertex array object receives the binding of the index buffer.
+func (buffers *drawListBuffers) upload(lists []imgui.DrawList, vertices, indices drawListData, stats *FrameStats) {
+	vertexSize, _, _, _ := imgui.VertexBufferLayout()
+
+	buffers.listOffsets = buffers.listOffsets[:0]
+	vertexCount := 0
+	vertexBufferSize := 0
+	indexBufferSize := 0
+	for listIndex, list := range lists {
+		_, listVertexSize := vertices(listIndex, list)
+		_, listIndexSize := indices(listIndex, list)
+		buffers.listOffsets = append(buffers.listOffsets, drawListOffsets{
+			baseVertex:  vertexBufferSize / vertexSize,
+			indexOffset: indexBufferSize,
+		})
+		vertexBufferSize += listVertexSize
+		indexBufferSize += listIndexSize
+		vertexCount += listVertexSize / vertexSize
+	}
+
+	if stats != nil {
+		stats.Vertices += vertexCount
+		stats.UploadBytes += vertexBufferSize + indexBufferSize
+	}
+
+	buffers.vertexBuffer.reserve(vertexBufferSize, buffers.strategy)
+	buffers.indexBuffer.reserve(indexBufferSize, buffers.strategy)
+
+	fn := buffers.vertexBuffer.fn
+	if buffers.strategy == BufferMapRange {
+		vertexOk := copyDrawLists(fn, glArrayBuffer, vertexBufferSize, lists, vertices)
+		indexOk := copyDrawLists(fn, glElementArrayBuffer, indexBufferSize, lists, indices)
+		if vertexOk && indexOk {
+			return
+		}
+		// The content of a buffer becomes undefined if unmapping fails, e.g. on a mode switch of the display.
+	}
+
+	vertexOffset := 0
+	indexOffset := 0
+	for listIndex, list := range lists {
+		vertexBuffer, listVertexSize := vertices(listIndex, list)
+		fn.BufferSubData(glArrayBuffer, vertexOffset, listVertexSize, vertexBuffer)
+		vertexOffset += listVertexSize
+
+		indexBuffer, listIndexSize := indices(listIndex, list)
+		fn.BufferSubData(glElementArrayBuffer, indexOffset, listIndexSize, indexBuffer)
+		indexOffset += listIndexSize
+	}
+}
+
+// drawListData returns the data of the list with the given index that is uploaded, and its size in bytes.
+type drawListData func(listIndex int, list imgui.DrawList) (unsafe.Pointer, int)
+
+// drawListVertexBuffer returns the vertices of the list as imgui created them.
+func drawListVertexBuffer(_ int, list imgui.DrawList) (unsafe.Pointer, int) {
+	return list.VertexBuffer()
+}
+
+// drawListIndexBuffer returns the indices of the list.
+func drawListIndexBuffer(_ int, list imgui.DrawList) (unsafe.Pointer, int) {
+	return list.IndexBuffer()
+}
+
+// copyDrawLists maps the buffer bound to target and copies the data of all lists into it, one after the other.
+// It returns false if the content of the buffer is undefined afterwards.
+func copyDrawLists(fn *glFunctions, target uint32, size int, lists []imgui.DrawList, data drawListData) bool {
+	if size == 0 {
+		return true
+	}
+	mapped := fn.MapBufferRange(target, 0, size, glMapWriteBit|glMapInvalidateBufferBit)
+	if mapped == nil {
+		return false
+	}
+	buffer := unsafe.Slice((*byte)(mapped), size)
+	offset := 0
+	for listIndex, list := range lists {
+		listData, listSize := data(listIndex, list)
+		offset += copy(buffer[offset:], unsafe.Slice((*byte)(listData), listSize))
+	}
+	return fn.UnmapBuffer(target)
+}
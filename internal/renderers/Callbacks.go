@@ -0,0 +1,119 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// DrawCallbackInfo describes the area a DrawCallback renders into.
+type DrawCallbackInfo struct {
+	// Rect is the area that was given to DrawCallbacks.Add(), in display coordinates.
+	Rect imgui.Vec4
+	// ClipRect is the clip rectangle of the draw list at the time of DrawCallbacks.Add(), in display coordinates.
+	ClipRect imgui.Vec4
+	// Scissor is the clip rectangle in framebuffer pixels, with the origin at the bottom left, as X, Y, width and height.
+	// It can be passed to glScissor() or glViewport() as is. The renderer has already set it as scissor box.
+	Scissor [4]int32
+	// FramebufferSize is the size of the framebuffer that is rendered into, in pixels.
+	FramebufferSize imgui.Vec2
+	// Projection is the orthographic projection of the renderer that maps display coordinates to clip space,
+	// as a column-major matrix.
+	Projection [4][4]float32
+}
+
+// DrawCallback renders custom content in place of a draw command of imgui.
+type DrawCallback func(info DrawCallbackInfo)
+
+// These texture IDs mark the draw commands that the registry adds to the draw lists.
+// The callbacks get the IDs below callbackTextureIDs.
+const (
+	resetRenderStateTextureID = ^imgui.TextureID(0)
+	beginSDFTextureID         = resetRenderStateTextureID - 1
+	endSDFTextureID           = resetRenderStateTextureID - 2
+	callbackTextureIDs        = resetRenderStateTextureID - 15
+)
+
+// isMarkerTextureID returns true for the texture IDs of the commands that only mark a position in the draw list.
+func isMarkerTextureID(id imgui.TextureID) bool {
+	return id > callbackTextureIDs
+}
+
+// DrawCallbacks is a registry of Go functions that are called by a renderer while it renders the draw data.
+// imgui-go can not store Go functions in a draw list, so the registry adds draw commands with reserved
+// texture IDs to the draw list instead, which the renderer recognizes.
+//
+// The callbacks are called with the render state of imgui. Any state a callback changes remains changed
+// for the following draw commands, unless AddResetRenderState() is called after adding the callback.
+//
+// A renderer clears its registry in NewFrame(), so callbacks have to be added again in each frame.
+type DrawCallbacks struct {
+	callbacks map[imgui.TextureID]callbackEntry
+	nextID    imgui.TextureID
+}
+
+type callbackEntry struct {
+	callback DrawCallback
+	rect     imgui.Vec4
+}
+
+func newDrawCallbacks() *DrawCallbacks {
+	registry := &DrawCallbacks{callbacks: make(map[imgui.TextureID]callbackEntry)}
+	registry.clear()
+	return registry
+}
+
+// Add adds a draw command to the draw list that calls the given function when it is rendered.
+// The rectangle from min to max is passed on to the function, and is typically the area of a widget
+// the function renders into. The current clip rectangle of the draw list applies.
+func (registry *DrawCallbacks) Add(list imgui.DrawList, min, max imgui.Vec2, callback DrawCallback) {
+	id := registry.nextID
+	registry.nextID--
+	registry.callbacks[id] = callbackEntry{
+		callback: callback,
+		rect:     imgui.Vec4{X: min.X, Y: min.Y, Z: max.X, W: max.Y},
+	}
+	list.AddImage(id, min, min)
+}
+
+// AddResetRenderState adds a draw command to the draw list that makes the renderer set up its render state again,
+// as it does at the start of rendering. This is typically added after a callback that changes the state.
+func (registry *DrawCallbacks) AddResetRenderState(list imgui.DrawList) {
+	list.AddImage(resetRenderStateTextureID, imgui.Vec2{}, imgui.Vec2{})
+}
+
+// AddBeginSDF adds a draw command to the draw list from which on text is drawn from the signed distance field
+// of the font atlas, if the renderer has SDF fonts enabled. Otherwise, the command is ignored.
+//
+// Only text should be drawn until AddEndSDF() is called. Other shapes are drawn solid, so the anti-aliased lines
+// that imgui bakes into the atlas lose their anti-aliasing.
+func (registry *DrawCallbacks) AddBeginSDF(list imgui.DrawList) {
+	list.AddImage(beginSDFTextureID, imgui.Vec2{}, imgui.Vec2{})
+}
+
+// AddEndSDF adds a draw command to the draw list from which on text is drawn from the regular font atlas again.
+func (registry *DrawCallbacks) AddEndSDF(list imgui.DrawList) {
+	list.AddImage(endSDFTextureID, imgui.Vec2{}, imgui.Vec2{})
+}
+
+func (registry *DrawCallbacks) clear() {
+	for id := range registry.callbacks {
+		delete(registry.callbacks, id)
+	}
+	registry.nextID = callbackTextureIDs
+}
+
+// lookup returns the callback that was added with the given texture ID, if any.
+func (registry *DrawCallbacks) lookup(id imgui.TextureID) (callbackEntry, bool) {
+	entry, isCallback := registry.callbacks[id]
+	return entry, isCallback
+}
+
+// call calls the callback of the given entry, with the scissor box already set by the renderer.
+func (entry callbackEntry) call(viewport drawViewport, clipRect imgui.Vec4, scissor [4]int32) {
+	entry.callback(DrawCallbackInfo{
+		Rect:            entry.rect,
+		ClipRect:        clipRect,
+		Scissor:         scissor,
+		FramebufferSize: viewport.framebufferSize,
+		Projection:      viewport.orthoProjection(),
+	})
+}
@@ -0,0 +1,81 @@
+package renderers
+
+import (
+	"image"
+	"math"
+	"unsafe"
+)
+
+// captureFramebuffer reads a region of the bound framebuffer, see captureRegion().
+// The pixel store state for packing, and the bound pixel pack buffer, are restored afterwards. OpenGL ES 2.0 has no other
+// pack state than the alignment, which is indicated by packAlignmentOnly.
+func captureFramebuffer(fn *glFunctions, region image.Rectangle, viewport drawViewport, packAlignmentOnly bool) (*image.RGBA, error) {
+	fbRegion := captureRegion(region, viewport)
+	if fbRegion.Empty() {
+		return nil, ErrEmptyCapture
+	}
+	img := image.NewRGBA(image.Rect(0, 0, fbRegion.Dx(), fbRegion.Dy()))
+
+	var lastPackAlignment int32
+	var lastPack [3]int32
+	var lastPackBuffer int32
+	packParameters := [...]uint32{glPackRowLength, glPackSkipRows, glPackSkipPixels}
+	fn.GetIntegerv(glPackAlignment, &lastPackAlignment)
+	fn.PixelStorei(glPackAlignment, 4)
+	if !packAlignmentOnly {
+		for i, parameter := range packParameters {
+			fn.GetIntegerv(parameter, &lastPack[i])
+			fn.PixelStorei(parameter, 0)
+		}
+		fn.GetIntegerv(glPixelPackBufferBinding, &lastPackBuffer)
+		fn.BindBuffer(glPixelPackBuffer, 0)
+	}
+
+	fn.ReadPixels(int32(fbRegion.Min.X), int32(viewport.framebufferSize.Y)-int32(fbRegion.Max.Y),
+		int32(fbRegion.Dx()), int32(fbRegion.Dy()), glRGBA, glUnsignedByte, unsafe.Pointer(&img.Pix[0]))
+
+	fn.PixelStorei(glPackAlignment, lastPackAlignment)
+	if !packAlignmentOnly {
+		for i, parameter := range packParameters {
+			fn.PixelStorei(parameter, lastPack[i])
+		}
+		fn.BindBuffer(glPixelPackBuffer, uint32(lastPackBuffer))
+	}
+
+	flipRows(img)
+	return img, nil
+}
+
+// captureRegion converts a region in display coordinates into a region of the framebuffer of the viewport, in pixels,
+// with the origin at the top left. An empty region selects the whole framebuffer.
+// The result is clipped to the framebuffer and may be empty.
+func captureRegion(region image.Rectangle, viewport drawViewport) image.Rectangle {
+	framebuffer := image.Rect(0, 0, int(viewport.framebufferSize.X), int(viewport.framebufferSize.Y))
+	if region.Empty() {
+		return framebuffer
+	}
+	if viewport.empty() {
+		return image.Rectangle{}
+	}
+
+	minX := (float64(region.Min.X) - float64(viewport.displayPos.X)) * float64(viewport.clipScale.X)
+	minY := (float64(region.Min.Y) - float64(viewport.displayPos.Y)) * float64(viewport.clipScale.Y)
+	maxX := (float64(region.Max.X) - float64(viewport.displayPos.X)) * float64(viewport.clipScale.X)
+	maxY := (float64(region.Max.Y) - float64(viewport.displayPos.Y)) * float64(viewport.clipScale.Y)
+	scaled := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY)))
+	return scaled.Intersect(framebuffer)
+}
+
+// flipRows turns the pixels as read from OpenGL, which have their origin at the bottom left, into a regular image.
+func flipRows(img *image.RGBA) {
+	height := img.Rect.Dy()
+	rowSize := img.Rect.Dx() * 4
+	row := make([]byte, rowSize)
+	for y := 0; y < height/2; y++ {
+		top := img.Pix[y*img.Stride : y*img.Stride+rowSize]
+		bottom := img.Pix[(height-1-y)*img.Stride : (height-1-y)*img.Stride+rowSize]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+}
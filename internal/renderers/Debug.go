@@ -0,0 +1,191 @@
+package renderers
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DebugSeverity classifies the messages of the debug output of OpenGL.
+type DebugSeverity int
+
+// This is a list of DebugSeverity constants, in increasing order.
+const (
+	DebugSeverityNotification DebugSeverity = iota
+	DebugSeverityLow
+	DebugSeverityMedium
+	DebugSeverityHigh
+)
+
+// DebugSources selects the origins of messages of the debug output of OpenGL.
+type DebugSources uint
+
+// This is a list of DebugSources constants.
+const (
+	DebugSourceAPI DebugSources = 1 << iota
+	DebugSourceWindowSystem
+	DebugSourceShaderCompiler
+	DebugSourceThirdParty
+	DebugSourceApplication
+	DebugSourceOther
+
+	// DebugSourceAll selects all sources.
+	DebugSourceAll = DebugSourceAPI | DebugSourceWindowSystem | DebugSourceShaderCompiler |
+		DebugSourceThirdParty | DebugSourceApplication | DebugSourceOther
+)
+
+// DebugOptions configure the debug output of a renderer, see EnableDebug() of the renderers.
+//
+// The debug output requires the KHR_debug extension. Most drivers only report in detail with a debug context,
+// see platforms.Options. The draw passes of the renderer are labeled with debug groups, which show up in tools
+// such as RenderDoc or apitrace.
+//
+// The debug output is a property of the context; the callback is shared by all users of the bindings.
+type DebugOptions struct {
+	// Logger receives the messages. If nil, slog.Default() is used.
+	Logger *slog.Logger
+	// MinSeverity is the lowest severity that is logged.
+	MinSeverity DebugSeverity
+	// Sources selects the origins of the logged messages. Zero selects all sources.
+	Sources DebugSources
+}
+
+// These are the enumeration values of KHR_debug, which are the same for all bindings.
+const (
+	glDebugSourceAPI            = 0x8246
+	glDebugSourceWindowSystem   = 0x8247
+	glDebugSourceShaderCompiler = 0x8248
+	glDebugSourceThirdParty     = 0x8249
+	glDebugSourceApplication    = 0x824A
+	glDebugSourceOther          = 0x824B
+
+	glDebugTypeError              = 0x824C
+	glDebugTypeDeprecatedBehavior = 0x824D
+	glDebugTypeUndefinedBehavior  = 0x824E
+	glDebugTypePortability        = 0x824F
+	glDebugTypePerformance        = 0x8250
+	glDebugTypeOther              = 0x8251
+	glDebugTypeMarker             = 0x8268
+	glDebugTypePushGroup          = 0x8269
+	glDebugTypePopGroup           = 0x826A
+
+	glDebugSeverityHigh         = 0x9146
+	glDebugSeverityMedium       = 0x9147
+	glDebugSeverityLow          = 0x9148
+	glDebugSeverityNotification = 0x826B
+)
+
+var debugSources = map[uint32]struct {
+	source DebugSources
+	name   string
+}{
+	glDebugSourceAPI:            {DebugSourceAPI, "api"},
+	glDebugSourceWindowSystem:   {DebugSourceWindowSystem, "window system"},
+	glDebugSourceShaderCompiler: {DebugSourceShaderCompiler, "shader compiler"},
+	glDebugSourceThirdParty:     {DebugSourceThirdParty, "third party"},
+	glDebugSourceApplication:    {DebugSourceApplication, "application"},
+	glDebugSourceOther:          {DebugSourceOther, "other"},
+}
+
+var debugTypeNames = map[uint32]string{
+	glDebugTypeError:              "error",
+	glDebugTypeDeprecatedBehavior: "deprecated behavior",
+	glDebugTypeUndefinedBehavior:  "undefined behavior",
+	glDebugTypePortability:        "portability",
+	glDebugTypePerformance:        "performance",
+	glDebugTypeOther:              "other",
+	glDebugTypeMarker:             "marker",
+	glDebugTypePushGroup:          "push group",
+	glDebugTypePopGroup:           "pop group",
+}
+
+var debugSeverities = map[uint32]struct {
+	severity DebugSeverity
+	level    slog.Level
+	name     string
+}{
+	glDebugSeverityNotification: {DebugSeverityNotification, slog.LevelDebug, "notification"},
+	glDebugSeverityLow:          {DebugSeverityLow, slog.LevelInfo, "low"},
+	glDebugSeverityMedium:       {DebugSeverityMedium, slog.LevelWarn, "medium"},
+	glDebugSeverityHigh:         {DebugSeverityHigh, slog.LevelError, "high"},
+}
+
+// debugLogger filters the messages of the debug output and forwards them to a logger.
+type debugLogger struct {
+	options DebugOptions
+}
+
+func newDebugLogger(options DebugOptions) *debugLogger {
+	if options.Logger == nil {
+		options.Logger = slog.Default()
+	}
+	if options.Sources == 0 {
+		options.Sources = DebugSourceAll
+	}
+	return &debugLogger{options: options}
+}
+
+// log receives a message from the debug callback of one of the bindings.
+func (logger *debugLogger) log(source, gltype, id, severity uint32, message string) {
+	sourceInfo, knownSource := debugSources[source]
+	if !knownSource {
+		sourceInfo = debugSources[glDebugSourceOther]
+	}
+	if (logger.options.Sources & sourceInfo.source) == 0 {
+		return
+	}
+	severityInfo, knownSeverity := debugSeverities[severity]
+	if !knownSeverity {
+		severityInfo = debugSeverities[glDebugSeverityNotification]
+	}
+	if severityInfo.severity < logger.options.MinSeverity {
+		return
+	}
+
+	logger.options.Logger.LogAttrs(context.Background(), severityInfo.level, message,
+		slog.String("source", sourceInfo.name),
+		slog.String("type", debugTypeNames[gltype]),
+		slog.Uint64("id", uint64(id)),
+		slog.String("severity", severityInfo.name))
+}
+
+// debugOutput is the debug output of the context of a renderer. The zero value is disabled.
+type debugOutput struct {
+	fn     *glFunctions
+	logger *debugLogger
+	groups bool
+}
+
+// enable sets the debug callback of the binding, and starts labeling the draw passes with debug groups.
+func (debug *debugOutput) enable(fn *glFunctions, options DebugOptions) error {
+	if !fn.HasExtension("GL_KHR_debug") {
+		return ErrDebugUnsupported
+	}
+
+	logger := newDebugLogger(options)
+	fn.DebugMessageCallback(logger.log)
+	fn.Enable(glDebugOutput)
+	fn.Enable(glDebugOutputSynchronous)
+	debug.fn = fn
+	debug.logger = logger
+	debug.groups = true
+	return nil
+}
+
+// warn logs a problem of the renderer itself, such as draws it had to skip, if the debug output is enabled.
+func (debug *debugOutput) warn(message string) {
+	if debug.logger != nil {
+		debug.logger.log(glDebugSourceApplication, glDebugTypeOther, 0, glDebugSeverityMedium, message)
+	}
+}
+
+func (debug *debugOutput) pushGroup(label string) {
+	if debug.groups {
+		debug.fn.PushDebugGroup(glDebugSourceApplication, 0, -1, debug.fn.Str(label+"\x00"))
+	}
+}
+
+func (debug *debugOutput) popGroup() {
+	if debug.groups {
+		debug.fn.PopDebugGroup()
+	}
+}
@@ -0,0 +1,150 @@
+package renderers
+
+import (
+	"image"
+	"math"
+)
+
+// DefaultDistanceFieldSpread is the spread of BuildDistanceField() that the renderers use if none is given.
+const DefaultDistanceFieldSpread = 4
+
+// BuildDistanceField converts the coverage of glyphs, as in the Alpha8 texture data of the font atlas, into a signed distance field
+// of the same size. The edge of a glyph, where the coverage is one half, maps to 128. Each step of 128/spread is one pixel
+// further inside (upwards) or outside (downwards), up to spread pixels from the edge.
+//
+// Anti-aliased coverage is taken into account, so that the edge is placed with sub-pixel precision.
+// Glyphs that are less than spread pixels apart in the atlas affect each other's field outside of their own bounds.
+func BuildDistanceField(coverage *image.Alpha, spread int) *image.Alpha {
+	if spread <= 0 {
+		spread = DefaultDistanceFieldSpread
+	}
+	bounds := coverage.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	field := image.NewAlpha(bounds)
+	if (width == 0) || (height == 0) {
+		return field
+	}
+
+	// The squared distances to the nearest pixel outside and inside the glyphs, as in the
+	// algorithm of TinySDF. Partial coverage moves the edge within the pixel.
+	outside := make([]float64, width*height)
+	inside := make([]float64, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			alpha := float64(coverage.AlphaAt(bounds.Min.X+x, bounds.Min.Y+y).A) / 0xFF
+			index := y*width + x
+			switch alpha {
+			case 1:
+				outside[index] = 0
+				inside[index] = math.Inf(1)
+			case 0:
+				outside[index] = math.Inf(1)
+				inside[index] = 0
+			default:
+				outside[index] = math.Pow(math.Max(0, 0.5-alpha), 2)
+				inside[index] = math.Pow(math.Max(0, alpha-0.5), 2)
+			}
+		}
+	}
+
+	transform := newDistanceTransform(width, height)
+	transform.apply(outside)
+	transform.apply(inside)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			index := y*width + x
+			distance := math.Sqrt(outside[index]) - math.Sqrt(inside[index])
+			value := 0.5 - distance/float64(2*spread)
+			field.Pix[y*field.Stride+x] = uint8(math.Round(math.Min(math.Max(value, 0), 1) * 0xFF))
+		}
+	}
+	return field
+}
+
+// distanceTransform is the squared Euclidean distance transform of Felzenszwalb and Huttenlocher,
+// with buffers for the one-dimensional passes along the longer side of the image.
+type distanceTransform struct {
+	width  int
+	height int
+
+	line       []float64
+	result     []float64
+	vertices   []int
+	boundaries []float64
+}
+
+func newDistanceTransform(width, height int) *distanceTransform {
+	size := width
+	if height > size {
+		size = height
+	}
+	return &distanceTransform{
+		width:      width,
+		height:     height,
+		line:       make([]float64, size),
+		result:     make([]float64, size),
+		vertices:   make([]int, size),
+		boundaries: make([]float64, size+1),
+	}
+}
+
+// apply replaces each value of the grid with the smallest sum of a value and the squared distance to it.
+func (transform *distanceTransform) apply(grid []float64) {
+	for x := 0; x < transform.width; x++ {
+		for y := 0; y < transform.height; y++ {
+			transform.line[y] = grid[y*transform.width+x]
+		}
+		transform.pass(transform.height)
+		for y := 0; y < transform.height; y++ {
+			grid[y*transform.width+x] = transform.result[y]
+		}
+	}
+	for y := 0; y < transform.height; y++ {
+		copy(transform.line, grid[y*transform.width:(y+1)*transform.width])
+		transform.pass(transform.width)
+		copy(grid[y*transform.width:(y+1)*transform.width], transform.result[:transform.width])
+	}
+}
+
+// pass computes the one-dimensional transform of the first length values of line into result,
+// as the lower envelope of the parabolas rooted at each value.
+func (transform *distanceTransform) pass(length int) {
+	line, vertices, boundaries := transform.line, transform.vertices, transform.boundaries
+
+	// Values of infinity have no parabola. If there are none at all, the result remains infinite.
+	count := 0
+	for q := 0; q < length; q++ {
+		if math.IsInf(line[q], 1) {
+			continue
+		}
+		var boundary float64
+		for count > 0 {
+			p := vertices[count-1]
+			boundary = ((line[q] + float64(q*q)) - (line[p] + float64(p*p))) / float64(2*(q-p))
+			if boundary > boundaries[count-1] {
+				break
+			}
+			count--
+		}
+		if count == 0 {
+			boundary = math.Inf(-1)
+		}
+		vertices[count] = q
+		boundaries[count] = boundary
+		count++
+	}
+
+	vertex := 0
+	for q := 0; q < length; q++ {
+		if count == 0 {
+			transform.result[q] = math.Inf(1)
+			continue
+		}
+		for (vertex+1 < count) && (boundaries[vertex+1] < float64(q)) {
+			vertex++
+		}
+		p := vertices[vertex]
+		transform.result[q] = float64((q-p)*(q-p)) + line[p]
+	}
+}
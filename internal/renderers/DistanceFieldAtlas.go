@@ -0,0 +1,136 @@
+package renderers
+
+import (
+	"image"
+	"image/draw"
+)
+
+// distanceFieldAtlas holds the signed distance fields of the glyphs that were drawn so far. The glyphs of the
+// font atlas are only one pixel apart, which is less than the spread of the field, so each glyph is placed
+// with a margin of the spread around it, in which the field of the glyph falls off without reaching its neighbors.
+//
+// Glyphs are identified by their rectangle in the font atlas, as found in the texture coordinates of the
+// vertices, and are placed in rows from the top left.
+type distanceFieldAtlas struct {
+	coverage *image.Alpha
+	spread   int
+
+	field  *image.Alpha
+	glyphs map[image.Rectangle]image.Rectangle
+	solid  image.Rectangle
+	dirty  bool
+
+	rowX      int
+	rowY      int
+	rowHeight int
+}
+
+// distanceFieldAtlasMinSize is the smallest width and height of the atlas.
+const distanceFieldAtlasMinSize = 64
+
+// newDistanceFieldAtlas returns an atlas for the glyphs of the given coverage, initially of the same size.
+func newDistanceFieldAtlas(coverage *image.Alpha, spread int) *distanceFieldAtlas {
+	if spread <= 0 {
+		spread = DefaultDistanceFieldSpread
+	}
+	atlas := &distanceFieldAtlas{coverage: coverage, spread: spread}
+	size := coverage.Bounds().Size()
+	minSize := distanceFieldAtlasMinSize
+	if 4*(spread+1) > minSize {
+		minSize = 4 * (spread + 1)
+	}
+	if size.X < minSize {
+		size.X = minSize
+	}
+	if size.Y < minSize {
+		size.Y = minSize
+	}
+	atlas.resize(size)
+	return atlas
+}
+
+// resize replaces the field with an empty one of the given size.
+func (atlas *distanceFieldAtlas) resize(size image.Point) {
+	atlas.field = image.NewAlpha(image.Rectangle{Max: size})
+	atlas.clear()
+}
+
+// clear removes all glyphs. Only the solid block remains, which is completely inside the edge.
+func (atlas *distanceFieldAtlas) clear() {
+	for i := range atlas.field.Pix {
+		atlas.field.Pix[i] = 0
+	}
+	atlas.glyphs = make(map[image.Rectangle]image.Rectangle)
+	atlas.rowX, atlas.rowY, atlas.rowHeight = 0, 0, 0
+	atlas.dirty = true
+
+	size := 2 * (atlas.spread + 1)
+	atlas.solid, _ = atlas.allocate(image.Pt(size, size))
+	draw.Draw(atlas.field, atlas.solid, image.Opaque, image.Point{}, draw.Src)
+}
+
+// prepare makes sure that all given glyphs are in the atlas. If they do not fit in addition to the glyphs
+// already placed, the atlas is cleared, and grows until they fit on their own.
+func (atlas *distanceFieldAtlas) prepare(glyphs []image.Rectangle) {
+	if atlas.placeAll(glyphs) {
+		return
+	}
+	atlas.clear()
+	for !atlas.placeAll(glyphs) {
+		atlas.resize(atlas.field.Bounds().Size().Mul(2))
+	}
+}
+
+// placeAll places the glyphs that are not in the atlas yet. It returns false if one of them does not fit.
+func (atlas *distanceFieldAtlas) placeAll(glyphs []image.Rectangle) bool {
+	for _, glyph := range glyphs {
+		if _, placed := atlas.glyphs[glyph]; placed {
+			continue
+		}
+		if !atlas.place(glyph) {
+			return false
+		}
+	}
+	return true
+}
+
+// place builds the field of the glyph, from its coverage alone, into a newly allocated area.
+func (atlas *distanceFieldAtlas) place(glyph image.Rectangle) bool {
+	margin := image.Pt(atlas.spread, atlas.spread)
+	area, fits := atlas.allocate(glyph.Size().Add(margin.Mul(2)))
+	if !fits {
+		return false
+	}
+	padded := image.NewAlpha(image.Rectangle{Max: area.Size()})
+	draw.Draw(padded, image.Rectangle{Min: margin, Max: margin.Add(glyph.Size())}, atlas.coverage, glyph.Min, draw.Src)
+	draw.Draw(atlas.field, area, BuildDistanceField(padded, atlas.spread), image.Point{}, draw.Src)
+	atlas.glyphs[glyph] = area
+	atlas.dirty = true
+	return true
+}
+
+// allocate reserves an area of the given size. Areas are kept one pixel apart, so that they are not
+// blended together by linear filtering.
+func (atlas *distanceFieldAtlas) allocate(size image.Point) (image.Rectangle, bool) {
+	bounds := atlas.field.Bounds()
+	if atlas.rowX+size.X > bounds.Dx() {
+		atlas.rowX = 0
+		atlas.rowY += atlas.rowHeight + 1
+		atlas.rowHeight = 0
+	}
+	if (size.X > bounds.Dx()) || (atlas.rowY+size.Y > bounds.Dy()) {
+		return image.Rectangle{}, false
+	}
+	area := image.Rectangle{Min: image.Pt(atlas.rowX, atlas.rowY), Max: image.Pt(atlas.rowX+size.X, atlas.rowY+size.Y)}
+	atlas.rowX += size.X + 1
+	if size.Y > atlas.rowHeight {
+		atlas.rowHeight = size.Y
+	}
+	return area, true
+}
+
+// lookup returns the area of a placed glyph, which includes the margin of the spread.
+func (atlas *distanceFieldAtlas) lookup(glyph image.Rectangle) (image.Rectangle, bool) {
+	area, placed := atlas.glyphs[glyph]
+	return area, placed
+}
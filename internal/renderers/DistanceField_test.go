@@ -0,0 +1,97 @@
+package renderers
+
+import (
+	"image"
+	"image/draw"
+	"testing"
+)
+
+// newCoverage returns coverage of the given size, with the rectangles fully covered.
+func newCoverage(size image.Point, rects ...image.Rectangle) *image.Alpha {
+	coverage := image.NewAlpha(image.Rectangle{Max: size})
+	for _, rect := range rects {
+		draw.Draw(coverage, rect, image.Opaque, image.Point{}, draw.Src)
+	}
+	return coverage
+}
+
+func TestBuildDistanceField(t *testing.T) {
+	const spread = 4
+	coverage := newCoverage(image.Pt(24, 16), image.Rect(8, 4, 16, 12))
+	field := BuildDistanceField(coverage, spread)
+	if field.Bounds() != coverage.Bounds() {
+		t.Fatalf("field has bounds %v, want %v", field.Bounds(), coverage.Bounds())
+	}
+
+	// Along the middle row, the field rises by 128/spread per pixel towards the edge, which lies between pixels 7 and 8.
+	row := func(x int) int { return int(field.AlphaAt(x, 8).A) }
+	for x, want := range map[int]int{3: 0, 4: 0, 5: 32, 6: 64, 7: 96, 8: 159} {
+		if got := row(x); (got < want-1) || (got > want+1) {
+			t.Errorf("field at %v is %v, want %v", x, got, want)
+		}
+	}
+	if edge := (row(7) + row(8)) / 2; (edge < 127) || (edge > 128) {
+		t.Errorf("edge between the pixels is at %v, want 128", edge)
+	}
+	for x := 8; x < 11; x++ {
+		if row(x+1) <= row(x) {
+			t.Errorf("field does not rise inside the glyph, at %v: %v, %v", x, row(x), row(x+1))
+		}
+	}
+
+	half := image.NewAlpha(image.Rect(0, 0, 3, 1))
+	half.Pix[1] = 0x80
+	if got := BuildDistanceField(half, spread).AlphaAt(1, 0).A; (got < 127) || (got > 129) {
+		t.Errorf("field of half coverage is %v, want 128", got)
+	}
+
+	if got := BuildDistanceField(coverage, 0); got.AlphaAt(4, 8).A != field.AlphaAt(4, 8).A {
+		t.Errorf("field without spread is %v, want the one of the default spread %v", got.AlphaAt(4, 8).A, field.AlphaAt(4, 8).A)
+	}
+}
+
+func TestDistanceFieldAtlas(t *testing.T) {
+	const spread = 4
+	// Two glyphs, one pixel apart as in the font atlas.
+	left, right := image.Rect(2, 2, 10, 10), image.Rect(11, 2, 19, 10)
+	atlas := newDistanceFieldAtlas(newCoverage(image.Pt(24, 12), left, right), spread)
+
+	// Enough glyphs so that the atlas has to grow.
+	glyphs := []image.Rectangle{left, right}
+	for i := 0; i < 64; i++ {
+		glyphs = append(glyphs, image.Rect(i%20, 0, i%20+4, 4+i/20))
+	}
+	atlas.prepare(glyphs)
+
+	var areas []image.Rectangle
+	for _, glyph := range glyphs {
+		area, placed := atlas.lookup(glyph)
+		if !placed {
+			t.Fatalf("glyph %v was not placed", glyph)
+		}
+		if area.Size() != glyph.Size().Add(image.Pt(2*spread, 2*spread)) {
+			t.Errorf("glyph %v has area %v, which does not include the spread", glyph, area)
+		}
+		if area.Overlaps(atlas.solid) {
+			t.Errorf("area %v overlaps the solid block %v", area, atlas.solid)
+		}
+		for _, other := range areas {
+			if area.Overlaps(other) && (area != other) {
+				t.Errorf("area %v overlaps %v", area, other)
+			}
+		}
+		areas = append(areas, area)
+	}
+
+	// The field of a glyph is not affected by its neighbor: it falls off the same to both sides.
+	area, _ := atlas.lookup(left)
+	y := area.Min.Y + area.Dy()/2
+	for x := 0; x < area.Dx()/2; x++ {
+		if a, b := atlas.field.AlphaAt(area.Min.X+x, y).A, atlas.field.AlphaAt(area.Max.X-1-x, y).A; a != b {
+			t.Errorf("field at %v from the sides differs: %v, %v", x, a, b)
+		}
+	}
+	if atlas.field.AlphaAt((atlas.solid.Min.X+atlas.solid.Max.X)/2, (atlas.solid.Min.Y+atlas.solid.Max.Y)/2).A != 0xFF {
+		t.Error("the center of the solid block is not inside the edge")
+	}
+}
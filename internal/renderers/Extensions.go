@@ -0,0 +1,14 @@
+package renderers
+
+import "strings"
+
+// containsExtension returns true if the space separated list of extensions, as returned by
+// glGetString(GL_EXTENSIONS), contains the named extension.
+func containsExtension(extensions string, name string) bool {
+	for _, extension := range strings.Fields(extensions) {
+		if extension == name {
+			return true
+		}
+	}
+	return false
+}
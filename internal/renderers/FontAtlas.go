@@ -0,0 +1,74 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// FontAtlasFormat selects the pixel format the font atlas is uploaded with.
+type FontAtlasFormat int
+
+// This is a list of FontAtlasFormat constants.
+const (
+	// FontAtlasAlpha8 uploads only the coverage of the glyphs, with one byte per pixel.
+	// All glyphs are drawn in the color of the text.
+	FontAtlasAlpha8 FontAtlasFormat = iota
+	// FontAtlasRGBA32 uploads the atlas with four bytes per pixel. This keeps the colors of
+	// custom rects, such as multi-colored icons or emoji, that were written into the RGBA32 texture data.
+	FontAtlasRGBA32
+)
+
+// fontAtlasImage identifies the texture data of the font atlas, so that a rebuilt atlas can be detected.
+type fontAtlasImage struct {
+	format FontAtlasFormat
+	width  int
+	height int
+	pixels unsafe.Pointer
+}
+
+// currentFontAtlasImage returns the texture data of the atlas in the given format. imgui builds the atlas if necessary,
+// and converts the coverage to white pixels for FontAtlasRGBA32.
+func currentFontAtlasImage(atlas imgui.FontAtlas, format FontAtlasFormat) fontAtlasImage {
+	if format == FontAtlasRGBA32 {
+		image := atlas.TextureDataRGBA32()
+		return fontAtlasImage{format: format, width: image.Width, height: image.Height, pixels: image.Pixels}
+	}
+	image := atlas.TextureDataAlpha8()
+	return fontAtlasImage{format: format, width: image.Width, height: image.Height, pixels: image.Pixels}
+}
+
+// fontAtlasRebuilt returns true if the font texture has to be created from the texture data of the atlas,
+// because there is no texture yet, or because the atlas was rebuilt since the texture was created from the given image.
+//
+// A rebuilt atlas is detected by its texture data having changed location or size. Code that changes
+// the fonts of the atlas should call RebuildFontsTexture() of the renderer instead of relying on this detection.
+func fontAtlasRebuilt(atlas imgui.FontAtlas, format FontAtlasFormat, texture uint32, uploaded fontAtlasImage) bool {
+	return (texture == 0) || (currentFontAtlasImage(atlas, format) != uploaded)
+}
+
+// createFontTexture uploads the texture data of the atlas into a new texture, with the given formats, and returns it.
+// The binding of the 2D texture and the unpack alignment are left unchanged.
+func createFontTexture(fn *glFunctions, image fontAtlasImage, internalFormat int32, format uint32) uint32 {
+	var lastTexture int32
+	fn.GetIntegerv(glTextureBinding2D, &lastTexture)
+	var lastUnpackAlignment int32
+	fn.GetIntegerv(glUnpackAlignment, &lastUnpackAlignment)
+
+	var texture uint32
+	fn.GenTextures(1, &texture)
+	fn.BindTexture(glTexture2D, texture)
+	fn.TexParameteri(glTexture2D, glTextureMinFilter, glLinear)
+	fn.TexParameteri(glTexture2D, glTextureMagFilter, glLinear)
+	// OpenGL ES 2.0 requires clamping for textures with a size that is not a power of two.
+	fn.TexParameteri(glTexture2D, glTextureWrapS, glClampToEdge)
+	fn.TexParameteri(glTexture2D, glTextureWrapT, glClampToEdge)
+	// The rows of the Alpha8 data are not padded to four bytes.
+	fn.PixelStorei(glUnpackAlignment, 1)
+	fn.TexImage2D(glTexture2D, 0, internalFormat, int32(image.width), int32(image.height),
+		0, format, glUnsignedByte, image.pixels)
+
+	fn.PixelStorei(glUnpackAlignment, lastUnpackAlignment)
+	fn.BindTexture(glTexture2D, uint32(lastTexture))
+	return texture
+}
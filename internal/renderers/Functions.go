@@ -0,0 +1,127 @@
+package renderers
+
+import (
+	"unsafe"
+)
+
+// glFunctions holds the functions of one of the generated OpenGL bindings, for code that the renderers share.
+//
+// All bindings are generated from the same registry, so their functions have the same signatures, and their enums
+// have the same values. The enums that the shared code uses are listed below.
+type glFunctions struct {
+	GetIntegerv func(pname uint32, data *int32)
+	IsEnabled   func(capability uint32) bool
+	Enable      func(capability uint32)
+	Disable     func(capability uint32)
+	BindBuffer  func(target uint32, buffer uint32)
+
+	GenBuffers     func(n int32, buffers *uint32)
+	DeleteBuffers  func(n int32, buffers *uint32)
+	BufferData     func(target uint32, size int, data unsafe.Pointer, usage uint32)
+	BufferSubData  func(target uint32, offset int, size int, data unsafe.Pointer)
+	MapBufferRange func(target uint32, offset int, length int, access uint32) unsafe.Pointer
+	UnmapBuffer    func(target uint32) bool
+
+	ActiveTexture         func(texture uint32)
+	BlendEquationSeparate func(modeRGB uint32, modeAlpha uint32)
+	BlendFuncSeparate     func(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32)
+	Viewport              func(x int32, y int32, width int32, height int32)
+	Scissor               func(x int32, y int32, width int32, height int32)
+
+	Str                func(str string) *uint8
+	Strs               func(strs ...string) (cstrs **uint8, free func())
+	CreateProgram      func() uint32
+	DeleteProgram      func(program uint32)
+	CreateShader       func(xtype uint32) uint32
+	DeleteShader       func(shader uint32)
+	AttachShader       func(program uint32, shader uint32)
+	DetachShader       func(program uint32, shader uint32)
+	ShaderSource       func(shader uint32, count int32, xstring **uint8, length *int32)
+	CompileShader      func(shader uint32)
+	GetShaderiv        func(shader uint32, pname uint32, params *int32)
+	GetShaderInfoLog   func(shader uint32, bufSize int32, length *int32, infoLog *uint8)
+	BindAttribLocation func(program uint32, index uint32, name *uint8)
+	LinkProgram        func(program uint32)
+	GetProgramiv       func(program uint32, pname uint32, params *int32)
+	GetProgramInfoLog  func(program uint32, bufSize int32, length *int32, infoLog *uint8)
+	GetUniformLocation func(program uint32, name *uint8) int32
+	GetAttribLocation  func(program uint32, name *uint8) int32
+	UseProgram         func(program uint32)
+
+	GenTextures    func(n int32, textures *uint32)
+	DeleteTextures func(n int32, textures *uint32)
+	BindTexture    func(target uint32, texture uint32)
+	TexParameteri  func(target uint32, pname uint32, param int32)
+	PixelStorei    func(pname uint32, param int32)
+	TexImage2D     func(target uint32, level int32, internalformat int32, width int32, height int32, border int32,
+		format uint32, xtype uint32, pixels unsafe.Pointer)
+	TexSubImage2D func(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32,
+		format uint32, xtype uint32, pixels unsafe.Pointer)
+	ReadPixels func(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer)
+
+	HasExtension func(name string) bool
+	// DebugMessageCallback adapts the callback to the callback type of the binding.
+	DebugMessageCallback func(callback func(source, gltype, id, severity uint32, message string))
+	PushDebugGroup       func(source uint32, id uint32, length int32, message *uint8)
+	PopDebugGroup        func()
+}
+
+// These are the enums of OpenGL that the shared code uses.
+const (
+	glTexture2D        = 0x0DE1
+	glTextureBinding2D = 0x8069
+	glTextureMinFilter = 0x2801
+	glTextureMagFilter = 0x2800
+	glTextureWrapS     = 0x2802
+	glTextureWrapT     = 0x2803
+	glLinear           = 0x2601
+	glNearest          = 0x2600
+	glClampToEdge      = 0x812F
+	glRepeat           = 0x2901
+	glMirroredRepeat   = 0x8370
+	glUnpackRowLength  = 0x0CF2
+	glUnpackAlignment  = 0x0CF5
+	glPackAlignment    = 0x0D05
+	glPackRowLength    = 0x0D02
+	glPackSkipRows     = 0x0D03
+	glPackSkipPixels   = 0x0D04
+
+	glActiveTexture             = 0x84E0
+	glTexture0                  = 0x84C0
+	glCurrentProgram            = 0x8B8D
+	glArrayBuffer               = 0x8892
+	glArrayBufferBinding        = 0x8894
+	glElementArrayBuffer        = 0x8893
+	glElementArrayBufferBinding = 0x8895
+	glBlend                     = 0x0BE2
+	glBlendSrcRGB               = 0x80C9
+	glBlendDstRGB               = 0x80C8
+	glBlendSrcAlpha             = 0x80CB
+	glBlendDstAlpha             = 0x80CA
+	glBlendEquationRGB          = 0x8009
+	glBlendEquationAlpha        = 0x883D
+	glCullFace                  = 0x0B44
+	glDepthTest                 = 0x0B71
+	glScissorTest               = 0x0C11
+	glViewport                  = 0x0BA2
+	glScissorBox                = 0x0C10
+
+	glStreamDraw             = 0x88E0
+	glMapWriteBit            = 0x0002
+	glMapInvalidateBufferBit = 0x0008
+
+	glVertexShader   = 0x8B31
+	glFragmentShader = 0x8B30
+	glCompileStatus  = 0x8B81
+	glLinkStatus     = 0x8B82
+	glInfoLogLength  = 0x8B84
+	glFalse          = 0
+
+	glDebugOutput            = 0x92E0
+	glDebugOutputSynchronous = 0x8242
+
+	glPixelPackBuffer        = 0x88EB
+	glPixelPackBufferBinding = 0x88ED
+	glRGBA                   = 0x1908
+	glUnsignedByte           = 0x1401
+)
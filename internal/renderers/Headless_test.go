@@ -0,0 +1,290 @@
+//go:build egl
+// +build egl
+
+package renderers_test
+
+import (
+	"image"
+	"strings"
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/example"
+	"github.com/jetsetilly/imgui-go-examples/internal/platforms"
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
+)
+
+// These tests render through EGL without a window system, for example with Mesa's llvmpipe on a CI machine.
+// They are skipped if no EGL context can be created.
+// Run them with: go test -tags egl -run Headless ./internal/renderers
+
+const (
+	headlessWidth  = 320
+	headlessHeight = 240
+	headlessFrames = 3
+	callbackSize   = 20
+)
+
+type headlessRenderer interface {
+	example.Renderer
+	example.Capturer
+	DrawCallbacks() *renderers.DrawCallbacks
+	Dispose()
+}
+
+// headlessApp shows a single window on a black background, and keeps the last rendered frame.
+type headlessApp struct {
+	t     *testing.T
+	frame *image.RGBA
+}
+
+func (app *headlessApp) Init(p example.Platform, r example.Renderer) error {
+	return nil
+}
+
+func (app *headlessApp) Frame() {
+	imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 100})
+	imgui.Begin("Headless")
+	imgui.Text("Hello, world!")
+	imgui.Button("Button")
+	imgui.End()
+}
+
+func (app *headlessApp) ClearColor() [4]float32 {
+	return [4]float32{0, 0, 0, 1}
+}
+
+func (app *headlessApp) RenderScene() {
+}
+
+func (app *headlessApp) AfterRender(r example.Renderer) {
+	frame, err := r.(example.Capturer).Capture(image.Rectangle{})
+	if err != nil {
+		app.t.Errorf("failed to capture frame: %v", err)
+		return
+	}
+	app.frame = frame
+}
+
+func (app *headlessApp) Shutdown() {
+}
+
+// headlessRenderers are the renderers the tests run with, along with the client API they require.
+var headlessRenderers = []struct {
+	name        string
+	clientAPI   platforms.HeadlessClientAPI
+	newRenderer func(io imgui.IO) (headlessRenderer, error)
+}{
+	{
+		name:      "OpenGL2",
+		clientAPI: platforms.HeadlessClientAPIOpenGL2,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGL2(io)
+		},
+	},
+	{
+		name:      "OpenGL2 buffer objects",
+		clientAPI: platforms.HeadlessClientAPIOpenGL2,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGL2V(io, renderers.OpenGL2BufferObjects)
+		},
+	},
+	{
+		name:      "OpenGL2 vertex array objects",
+		clientAPI: platforms.HeadlessClientAPIOpenGL2,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGL2V(io, renderers.OpenGL2VertexArrayObjects)
+		},
+	},
+	{
+		name:      "OpenGL3",
+		clientAPI: platforms.HeadlessClientAPIOpenGL3,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGL3(io)
+		},
+	},
+	{
+		name:      "OpenGLES2",
+		clientAPI: platforms.HeadlessClientAPIOpenGLES2,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGLES(io)
+		},
+	},
+	{
+		name:      "OpenGLES3",
+		clientAPI: platforms.HeadlessClientAPIOpenGLES3,
+		newRenderer: func(io imgui.IO) (headlessRenderer, error) {
+			return renderers.NewOpenGLES(io)
+		},
+	},
+}
+
+// runHeadless renders headlessFrames frames with each of the renderers. For each renderer, newApp creates
+// the application, and check examines it after the frames have been rendered.
+func runHeadless(t *testing.T, newApp func(t *testing.T) example.Application, check func(t *testing.T, app example.Application)) {
+	for _, test := range headlessRenderers {
+		t.Run(test.name, func(t *testing.T) {
+			context := imgui.CreateContext(nil)
+			defer context.Destroy()
+			io := imgui.CurrentIO()
+
+			platform, err := platforms.NewHeadless(io, test.clientAPI, headlessWidth, headlessHeight)
+			if err != nil {
+				t.Skipf("no EGL context: %v", err)
+			}
+			defer platform.Dispose()
+			platform.SetFrameLimit(headlessFrames)
+
+			renderer, err := test.newRenderer(io)
+			if err != nil {
+				t.Fatalf("failed to create renderer: %v", err)
+			}
+			defer renderer.Dispose()
+
+			app := newApp(t)
+			err = example.RunV(platform, renderer, app, example.Pacing{})
+			if err != nil {
+				t.Fatalf("failed to run: %v", err)
+			}
+			check(t, app)
+		})
+	}
+}
+
+// drawnPixels returns the number of pixels of the frame that are not black.
+func drawnPixels(frame *image.RGBA) int {
+	drawn := 0
+	for offset := 0; offset < len(frame.Pix); offset += 4 {
+		pixel := frame.Pix[offset : offset+3]
+		if (pixel[0] != 0) || (pixel[1] != 0) || (pixel[2] != 0) {
+			drawn++
+		}
+	}
+	return drawn
+}
+
+func TestHeadlessRender(t *testing.T) {
+	runHeadless(t, func(t *testing.T) example.Application {
+		return &headlessApp{t: t}
+	}, func(t *testing.T, application example.Application) {
+		app := application.(*headlessApp)
+		if app.frame == nil {
+			t.Fatal("no frame was captured")
+		}
+		if app.frame.Bounds() != image.Rect(0, 0, headlessWidth, headlessHeight) {
+			t.Fatalf("captured frame has bounds %v", app.frame.Bounds())
+		}
+		if drawnPixels(app.frame) == 0 {
+			t.Error("the window was not drawn")
+		}
+	})
+}
+
+// callbackApp adds a draw callback, followed by a reset of the render state, to a window in each frame.
+type callbackApp struct {
+	headlessApp
+	callbacks *renderers.DrawCallbacks
+	infos     []renderers.DrawCallbackInfo
+}
+
+func (app *callbackApp) Init(p example.Platform, r example.Renderer) error {
+	app.callbacks = r.(headlessRenderer).DrawCallbacks()
+	return nil
+}
+
+func (app *callbackApp) Frame() {
+	imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 100})
+	imgui.Begin("Callback")
+	min := imgui.CursorScreenPos()
+	max := imgui.Vec2{X: min.X + callbackSize, Y: min.Y + callbackSize}
+	list := imgui.WindowDrawList()
+	app.callbacks.Add(list, min, max, func(info renderers.DrawCallbackInfo) {
+		app.infos = append(app.infos, info)
+	})
+	app.callbacks.AddResetRenderState(list)
+	imgui.Dummy(imgui.Vec2{X: callbackSize, Y: callbackSize})
+	imgui.Button("Button")
+	imgui.End()
+}
+
+func TestHeadlessDrawCallbacks(t *testing.T) {
+	runHeadless(t, func(t *testing.T) example.Application {
+		return &callbackApp{headlessApp: headlessApp{t: t}}
+	}, func(t *testing.T, application example.Application) {
+		app := application.(*callbackApp)
+		if len(app.infos) != headlessFrames {
+			t.Fatalf("the callback was called %d times, want %d", len(app.infos), headlessFrames)
+		}
+		info := app.infos[len(app.infos)-1]
+		if info.FramebufferSize != (imgui.Vec2{X: headlessWidth, Y: headlessHeight}) {
+			t.Errorf("framebuffer size is %v", info.FramebufferSize)
+		}
+		if (info.Rect.Z-info.Rect.X != callbackSize) || (info.Rect.W-info.Rect.Y != callbackSize) {
+			t.Errorf("callback rectangle is %v, want a size of %v", info.Rect, callbackSize)
+		}
+		if (info.Scissor[2] <= 0) || (info.Scissor[3] <= 0) {
+			t.Errorf("scissor box %v is empty", info.Scissor)
+		}
+		if app.frame == nil {
+			t.Fatal("no frame was captured")
+		}
+	})
+}
+
+// sdfApp draws the text of a window between the SDF markers, with SDF fonts enabled on the renderers that support them.
+type sdfApp struct {
+	headlessApp
+	callbacks *renderers.DrawCallbacks
+	enabled   bool
+}
+
+func (app *sdfApp) Init(p example.Platform, r example.Renderer) error {
+	app.callbacks = r.(headlessRenderer).DrawCallbacks()
+	sdfRenderer, supported := r.(interface {
+		EnableSDF(options renderers.SDFOptions) error
+	})
+	if !supported {
+		return nil
+	}
+	err := sdfRenderer.EnableSDF(renderers.SDFOptions{
+		OutlineColor: imgui.Vec4{X: 0, Y: 0, Z: 0, W: 1},
+		OutlineWidth: 1,
+		ShadowColor:  imgui.Vec4{X: 0, Y: 0, Z: 0, W: 0.5},
+		ShadowOffset: imgui.Vec2{X: 1, Y: 1},
+	})
+	app.enabled = err == nil
+	return err
+}
+
+func (app *sdfApp) Frame() {
+	imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 100})
+	imgui.Begin("SDF")
+	list := imgui.WindowDrawList()
+	app.callbacks.AddBeginSDF(list)
+	imgui.Text("Hello, world!")
+	app.callbacks.AddEndSDF(list)
+	imgui.Button("Button")
+	imgui.End()
+}
+
+func TestHeadlessSDF(t *testing.T) {
+	runHeadless(t, func(t *testing.T) example.Application {
+		return &sdfApp{headlessApp: headlessApp{t: t}}
+	}, func(t *testing.T, application example.Application) {
+		app := application.(*sdfApp)
+		if app.frame == nil {
+			t.Fatal("no frame was captured")
+		}
+		// Of the tested renderers, only OpenGL3 supports SDF fonts.
+		if wantEnabled := strings.HasSuffix(t.Name(), "/OpenGL3"); app.enabled != wantEnabled {
+			t.Errorf("SDF fonts enabled: %v, want %v", app.enabled, wantEnabled)
+		}
+		if drawnPixels(app.frame) == 0 {
+			t.Errorf("the window was not drawn, with SDF fonts enabled: %v", app.enabled)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package renderers
 
 import (
 	"fmt"
+	"image"
 	"unsafe"
 
 	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.1/gl"
@@ -13,18 +14,77 @@ type OpenGL2 struct {
 	imguiIO imgui.IO
 
 	fontTexture uint32
+	fontFormat  FontAtlasFormat
+	fontImage   fontAtlasImage
+	textures    userTextures
+	stateGroups StateGroups
+	debug       debugOutput
+	callbacks   *DrawCallbacks
+	stats       frameStats
+	timer       *timerQueries
+	buffers     *openGL2Buffers
+	transparent bool
+
+	viewport drawViewport
 }
 
-// NewOpenGL2 attempts to initialize a renderer.
+// openGL2Functions are the functions of the bindings of the OpenGL2 renderer, for shared code.
+var openGL2Functions = &glFunctions{
+	GetIntegerv: gl.GetIntegerv,
+	Enable:      gl.Enable,
+	BindBuffer:  gl.BindBuffer,
+
+	GenBuffers:     gl.GenBuffers,
+	DeleteBuffers:  gl.DeleteBuffers,
+	BufferData:     gl.BufferData,
+	BufferSubData:  gl.BufferSubData,
+	MapBufferRange: gl.MapBufferRange,
+	UnmapBuffer:    gl.UnmapBuffer,
+
+	Str: gl.Str,
+
+	GenTextures:    gl.GenTextures,
+	DeleteTextures: gl.DeleteTextures,
+	BindTexture:    gl.BindTexture,
+	TexParameteri:  gl.TexParameteri,
+	PixelStorei:    gl.PixelStorei,
+	TexImage2D:     gl.TexImage2D,
+	TexSubImage2D:  gl.TexSubImage2D,
+	ReadPixels:     gl.ReadPixels,
+
+	HasExtension:         hasOpenGL2Extension,
+	DebugMessageCallback: openGL2DebugMessageCallback,
+	PushDebugGroup:       gl.PushDebugGroup,
+	PopDebugGroup:        gl.PopDebugGroup,
+}
+
+// NewOpenGL2 attempts to initialize a renderer, which draws from client-side vertex arrays.
 // An OpenGL context has to be established before calling this function.
 func NewOpenGL2(io imgui.IO) (*OpenGL2, error) {
+	return NewOpenGL2V(io, OpenGL2ClientArrays)
+}
+
+// NewOpenGL2V attempts to initialize a renderer, which passes the vertices to OpenGL as selected.
+// An OpenGL context has to be established before calling this function.
+func NewOpenGL2V(io imgui.IO, vertices OpenGL2Vertices) (*OpenGL2, error) {
 	err := gl.Init()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize OpenGL: %w", err)
 	}
 
 	renderer := &OpenGL2{
-		imguiIO: io,
+		imguiIO:     io,
+		textures:    newUserTextures(openGL2Functions),
+		fontFormat:  FontAtlasRGBA32,
+		stateGroups: StateAll,
+		callbacks:   newDrawCallbacks(),
+		timer:       newOpenGL2TimerQueries(),
+	}
+	switch vertices {
+	case OpenGL2BufferObjects:
+		renderer.buffers = newOpenGL2Buffers(false)
+	case OpenGL2VertexArrayObjects:
+		renderer.buffers = newOpenGL2Buffers(hasOpenGL2Extension("GL_ARB_vertex_array_object"))
 	}
 	renderer.createFontsTexture()
 	return renderer, nil
@@ -32,67 +92,93 @@ func NewOpenGL2(io imgui.IO) (*OpenGL2, error) {
 
 // Dispose cleans up the resources.
 func (renderer *OpenGL2) Dispose() {
+	renderer.textures.freeAll()
 	renderer.destroyFontsTexture()
+	renderer.timer.delete()
+	renderer.timer = nil
+	if renderer.buffers != nil {
+		renderer.buffers.delete()
+		renderer.buffers = nil
+	}
 }
 
-// PreRender clears the framebuffer.
-func (renderer *OpenGL2) PreRender(clearColor [3]float32) {
-	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], 1.0)
-	gl.Clear(gl.COLOR_BUFFER_BIT)
+// NewFrame prepares the renderer for a new frame and must be called before imgui.NewFrame().
+// It clears the draw callbacks of the previous frame, and recreates the font texture if the font atlas was rebuilt.
+func (renderer *OpenGL2) NewFrame() {
+	renderer.callbacks.clear()
+	renderer.stats.nextFrame()
+
+	if fontAtlasRebuilt(renderer.imguiIO.Fonts(), renderer.fontFormat, renderer.fontTexture, renderer.fontImage) {
+		renderer.RebuildFontsTexture()
+	}
 }
 
-// Render translates the ImGui draw data to OpenGL3 commands.
-func (renderer *OpenGL2) Render(displaySize [2]float32, framebufferSize [2]float32, drawData imgui.DrawData) {
-	// Avoid rendering when minimized, scale coordinates for retina displays (screen coordinates != framebuffer coordinates)
-	displayWidth, displayHeight := displaySize[0], displaySize[1]
-	fbWidth, fbHeight := framebufferSize[0], framebufferSize[1]
-	if (fbWidth <= 0) || (fbHeight <= 0) {
+// RebuildFontsTexture replaces the font texture with one created from the current state of the font atlas.
+// The new texture is stored as the texture ID of the atlas. Any previous texture ID becomes invalid.
+// It must be called after fonts have been added to or removed from the atlas, and not between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGL2) RebuildFontsTexture() {
+	renderer.destroyFontsTexture()
+	renderer.createFontsTexture()
+}
+
+// SetStateBackup selects the groups of OpenGL state that Render() saves before rendering and restores afterwards.
+// The default is StateAll. Hosts that set up all of their state themselves can pass StateNone.
+func (renderer *OpenGL2) SetStateBackup(groups StateGroups) {
+	renderer.stateGroups = groups
+}
+
+// SetFontAtlasFormat selects the pixel format of the font texture, and recreates the texture if the format changes.
+// The default is FontAtlasRGBA32. It must not be called between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGL2) SetFontAtlasFormat(format FontAtlasFormat) {
+	if format == renderer.fontFormat {
 		return
 	}
-	drawData.ScaleClipRects(imgui.Vec2{
-		X: fbWidth / displayWidth,
-		Y: fbHeight / displayHeight,
-	})
+	renderer.fontFormat = format
+	renderer.RebuildFontsTexture()
+}
 
-	// Setup render state: alpha-blending enabled, no face culling, no depth testing, scissor enabled, vertex/texcoord/color pointers, polygon fill.
-	var lastTexture int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
-	var lastPolygonMode [2]int32
-	gl.GetIntegerv(gl.POLYGON_MODE, &lastPolygonMode[0])
-	var lastViewport [4]int32
-	gl.GetIntegerv(gl.VIEWPORT, &lastViewport[0])
-	var lastScissorBox [4]int32
-	gl.GetIntegerv(gl.SCISSOR_BOX, &lastScissorBox[0])
-	gl.PushAttrib(gl.ENABLE_BIT | gl.COLOR_BUFFER_BIT | gl.TRANSFORM_BIT)
-	gl.Enable(gl.BLEND)
-	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
-	gl.Disable(gl.CULL_FACE)
-	gl.Disable(gl.DEPTH_TEST)
-	gl.Disable(gl.LIGHTING)
-	gl.Disable(gl.COLOR_MATERIAL)
-	gl.Enable(gl.SCISSOR_TEST)
-	gl.EnableClientState(gl.VERTEX_ARRAY)
-	gl.EnableClientState(gl.TEXTURE_COORD_ARRAY)
-	gl.EnableClientState(gl.COLOR_ARRAY)
-	gl.Enable(gl.TEXTURE_2D)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
+// DrawCallbacks returns the registry of the functions that are called while rendering the current frame.
+func (renderer *OpenGL2) DrawCallbacks() *DrawCallbacks {
+	return renderer.callbacks
+}
 
-	// You may want this if using this code in an OpenGL 3+ context where shaders may be bound
-	// gl.UseProgram(0)
+// SetTransparent selects whether the framebuffer is the one of a transparent window, see platforms.Options.
+// Compositors expect premultiplied alpha from such windows, so the clear color is then premultiplied.
+// The default is false, for opaque windows.
+func (renderer *OpenGL2) SetTransparent(transparent bool) {
+	renderer.transparent = transparent
+}
 
-	// Setup viewport, orthographic projection matrix
-	// Our visible imgui space lies from draw_data->DisplayPos (top left) to draw_data->DisplayPos+data_data->DisplaySize (bottom right).
-	// DisplayMin is typically (0,0) for single viewport apps.
-	gl.Viewport(0, 0, int32(fbWidth), int32(fbHeight))
-	gl.MatrixMode(gl.PROJECTION)
-	gl.PushMatrix()
-	gl.LoadIdentity()
-	gl.Ortho(0, float64(displayWidth), float64(displayHeight), 0, -1, 1)
-	gl.MatrixMode(gl.MODELVIEW)
-	gl.PushMatrix()
-	gl.LoadIdentity()
+// PreRender clears the framebuffer. The clear color has straight alpha. It is written as given,
+// or with premultiplied alpha if the framebuffer is transparent, see SetTransparent().
+func (renderer *OpenGL2) PreRender(clearColor [4]float32) {
+	if renderer.transparent {
+		clearColor = [4]float32{clearColor[0] * clearColor[3], clearColor[1] * clearColor[3], clearColor[2] * clearColor[3], clearColor[3]}
+	}
+	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+}
+
+// Render translates the ImGui draw data to OpenGL2 commands.
+// The display position, display size, and framebuffer scale of the draw data determine the area that is rendered.
+func (renderer *OpenGL2) Render(drawData imgui.DrawData) {
+	viewport := newDrawViewport(drawData)
+	renderer.viewport = viewport
+
+	// Avoid rendering when minimized
+	if viewport.empty() {
+		return
+	}
+
+	renderer.debug.pushGroup("imgui")
+	renderer.timer.begin()
+
+	// Backup GL state
+	lastState := CaptureOpenGL2State(renderer.stateGroups, renderer.usesVertexArrayObject())
+	renderer.setupRenderState(viewport)
+	tracker := newDrawTracker(&renderer.stats.current)
+	vertexSize, _, _, _ := imgui.VertexBufferLayout()
 
-	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
 	indexSize := imgui.IndexBufferLayout()
 
 	drawType := gl.UNSIGNED_SHORT
@@ -102,23 +188,55 @@ func (renderer *OpenGL2) Render(displaySize [2]float32, framebufferSize [2]float
 	}
 
 	// Render command lists
-	for _, commandList := range drawData.CommandLists() {
-		vertexBuffer, _ := commandList.VertexBuffer()
-		indexBuffer, _ := commandList.IndexBuffer()
-		indexBufferOffset := uintptr(indexBuffer)
+	lists := drawData.CommandLists()
+	if renderer.buffers != nil {
+		renderer.buffers.upload(lists, drawListVertexBuffer, drawListIndexBuffer, &renderer.stats.current)
+	}
+	for listIndex, commandList := range lists {
+		vertexBuffer, vertexBufferSize := commandList.VertexBuffer()
+		indexBuffer, indexBufferSize := commandList.IndexBuffer()
+		if renderer.buffers == nil {
+			renderer.stats.current.Vertices += vertexBufferSize / vertexSize
+			renderer.stats.current.UploadBytes += vertexBufferSize + indexBufferSize
+		}
 
-		gl.VertexPointer(2, gl.FLOAT, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetPos)))
-		gl.TexCoordPointer(2, gl.FLOAT, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetUv)))
-		gl.ColorPointer(4, gl.UNSIGNED_BYTE, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetCol)))
+		// Indices are given as pointer into the draw list for client-side arrays, and as offset into the index buffer otherwise.
+		indexBufferOffset := uintptr(indexBuffer)
+		setVertexPointers := func() { setOpenGL2VertexPointers(vertexBuffer) }
+		if renderer.buffers != nil {
+			offsets := renderer.buffers.listOffsets[listIndex]
+			indexBufferOffset = uintptr(offsets.indexOffset)
+			setVertexPointers = func() { setOpenGL2VertexOffsets(offsets.baseVertex * vertexSize) }
+		}
+		setVertexPointers()
 
 		for _, command := range commandList.Commands() {
 			if command.HasUserCallback() {
 				command.CallUserCallback(commandList)
+				tracker.forget()
+			} else if command.TextureID() == resetRenderStateTextureID {
+				renderer.setupRenderState(viewport)
+				setVertexPointers()
+				tracker.forget()
+			} else if isMarkerTextureID(command.TextureID()) {
+				// SDF fonts are not supported, the text is drawn from the regular font atlas.
 			} else {
-				clipRect := command.ClipRect()
-				gl.Scissor(int32(clipRect.X), int32(fbHeight)-int32(clipRect.W), int32(clipRect.Z-clipRect.X), int32(clipRect.W-clipRect.Y))
-				gl.BindTexture(gl.TEXTURE_2D, uint32(command.TextureID()))
-				gl.DrawElementsWithOffset(gl.TRIANGLES, int32(command.ElementCount()), uint32(drawType), indexBufferOffset)
+				x, y, width, height, visible := viewport.scissor(command.ClipRect())
+				if visible {
+					if tracker.setScissor([4]int32{x, y, width, height}) {
+						gl.Scissor(x, y, width, height)
+					}
+					if callback, isCallback := renderer.callbacks.lookup(command.TextureID()); isCallback {
+						callback.call(viewport, command.ClipRect(), [4]int32{x, y, width, height})
+						tracker.forget()
+					} else {
+						if tracker.bindTexture(uint32(command.TextureID())) {
+							gl.BindTexture(gl.TEXTURE_2D, uint32(command.TextureID()))
+						}
+						tracker.draw(command.ElementCount())
+						gl.DrawElementsWithOffset(gl.TRIANGLES, int32(command.ElementCount()), uint32(drawType), indexBufferOffset)
+					}
+				}
 			}
 
 			indexBufferOffset += uintptr(command.ElementCount() * indexSize)
@@ -129,37 +247,92 @@ func (renderer *OpenGL2) Render(displaySize [2]float32, framebufferSize [2]float
 	gl.DisableClientState(gl.COLOR_ARRAY)
 	gl.DisableClientState(gl.TEXTURE_COORD_ARRAY)
 	gl.DisableClientState(gl.VERTEX_ARRAY)
-	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
-	gl.MatrixMode(gl.MODELVIEW)
-	gl.PopMatrix()
+	lastState.Restore()
+	renderer.timer.end()
+	renderer.debug.popGroup()
+}
+
+// setupRenderState sets the state for rendering the draw data. It is called at the start of rendering,
+// and again for each command added by DrawCallbacks.AddResetRenderState().
+func (renderer *OpenGL2) setupRenderState(viewport drawViewport) {
+	// Setup render state: alpha-blending enabled, no face culling, no depth testing, scissor enabled, vertex/texcoord/color pointers, polygon fill.
+	// The alpha channel is blended separately, so that rendering into a transparent framebuffer results in premultiplied alpha.
+	// With a vertex array object, the client states are enabled in it.
+	// A shader program, or buffers that the host or a draw callback left bound, would take precedence over the
+	// fixed-function pipeline and the client-side arrays.
+	gl.UseProgram(0)
+	if renderer.buffers != nil {
+		renderer.buffers.bind()
+	} else {
+		gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, 0)
+	}
+	gl.Enable(gl.BLEND)
+	gl.BlendFuncSeparate(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA)
+	gl.Disable(gl.CULL_FACE)
+	gl.Disable(gl.DEPTH_TEST)
+	gl.Disable(gl.LIGHTING)
+	gl.Disable(gl.COLOR_MATERIAL)
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.EnableClientState(gl.VERTEX_ARRAY)
+	gl.EnableClientState(gl.TEXTURE_COORD_ARRAY)
+	gl.EnableClientState(gl.COLOR_ARRAY)
+	gl.Enable(gl.TEXTURE_2D)
+	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
+
+	// Setup viewport, orthographic projection matrix
+	gl.Viewport(0, 0, int32(viewport.framebufferSize.X), int32(viewport.framebufferSize.Y))
 	gl.MatrixMode(gl.PROJECTION)
-	gl.PopMatrix()
-	gl.PopAttrib()
-	gl.PolygonMode(gl.FRONT, uint32(lastPolygonMode[0]))
-	gl.PolygonMode(gl.BACK, uint32(lastPolygonMode[1]))
-	gl.Viewport(lastViewport[0], lastViewport[1], lastViewport[2], lastViewport[3])
-	gl.Scissor(lastScissorBox[0], lastScissorBox[1], lastScissorBox[2], lastScissorBox[3])
+	gl.LoadIdentity()
+	gl.Ortho(float64(viewport.displayPos.X), float64(viewport.displayPos.X+viewport.displaySize.X),
+		float64(viewport.displayPos.Y+viewport.displaySize.Y), float64(viewport.displayPos.Y), -1, 1)
+	gl.MatrixMode(gl.MODELVIEW)
+	gl.LoadIdentity()
+}
+
+// usesVertexArrayObject returns true if the renderer keeps its vertex arrays in a vertex array object.
+func (renderer *OpenGL2) usesVertexArrayObject() bool {
+	return (renderer.buffers != nil) && (renderer.buffers.vertexArray != 0)
+}
+
+// setOpenGL2VertexPointers points the client side vertex arrays to the vertices of a command list.
+func setOpenGL2VertexPointers(vertexBuffer unsafe.Pointer) {
+	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
+	gl.VertexPointer(2, gl.FLOAT, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetPos)))
+	gl.TexCoordPointer(2, gl.FLOAT, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetUv)))
+	gl.ColorPointer(4, gl.UNSIGNED_BYTE, int32(vertexSize), unsafe.Pointer(uintptr(vertexBuffer)+uintptr(vertexOffsetCol)))
+}
+
+// Capture reads back the frame that was last rendered. It must be called after Render() and before the
+// display buffer is swapped, as the content of the buffer is undefined afterwards.
+// The region is given in display coordinates, the same as imgui uses for window positions. An empty region captures
+// the whole frame. The returned image has the size of the captured region in framebuffer pixels.
+//
+// The alpha channel is the one of the framebuffer, with premultiplied alpha, as image.RGBA expects. It is opaque unless
+// the clear color, or what was drawn, is transparent, and the framebuffer has an alpha channel.
+func (renderer *OpenGL2) Capture(region image.Rectangle) (*image.RGBA, error) {
+	return captureFramebuffer(openGL2Functions, region, renderer.viewport, false)
 }
 
 func (renderer *OpenGL2) createFontsTexture() {
 	// Build texture atlas
-	image := renderer.imguiIO.Fonts().TextureDataRGBA32()
+	image := currentFontAtlasImage(renderer.imguiIO.Fonts(), renderer.fontFormat)
 
 	// Upload texture to graphics system
-	var lastTexture int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
-	gl.GenTextures(1, &renderer.fontTexture)
-	gl.BindTexture(gl.TEXTURE_2D, renderer.fontTexture)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	// An ALPHA texture is modulated with the vertex color by the fixed pipeline, which tints the glyphs with the text color.
+	format := int32(gl.ALPHA)
+	if image.format == FontAtlasRGBA32 {
+		format = gl.RGBA
+	}
+	var lastUnpackRowLength int32
+	gl.GetIntegerv(gl.UNPACK_ROW_LENGTH, &lastUnpackRowLength)
 	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, int32(image.Width), int32(image.Height), 0, gl.RGBA, gl.UNSIGNED_BYTE, image.Pixels)
+	renderer.fontTexture = createFontTexture(openGL2Functions, image, format, uint32(format))
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, lastUnpackRowLength)
+	renderer.fontImage = image
 
 	// Store our identifier
 	renderer.imguiIO.Fonts().SetTextureID(imgui.TextureID(renderer.fontTexture))
-
-	// Restore state
-	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
 }
 
 func (renderer *OpenGL2) destroyFontsTexture() {
@@ -167,5 +340,6 @@ func (renderer *OpenGL2) destroyFontsTexture() {
 		gl.DeleteTextures(1, &renderer.fontTexture)
 		imgui.CurrentIO().Fonts().SetTextureID(0)
 		renderer.fontTexture = 0
+		renderer.fontImage = fontAtlasImage{}
 	}
 }
@@ -0,0 +1,64 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.1/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// OpenGL2Vertices selects how the OpenGL2 renderer passes the vertices and indices of the draw lists to OpenGL.
+type OpenGL2Vertices int
+
+// This is a list of OpenGL2Vertices constants.
+const (
+	// OpenGL2ClientArrays points client-side vertex arrays at the memory of the draw lists, which the driver
+	// reads from during each draw call. This is the default.
+	OpenGL2ClientArrays OpenGL2Vertices = iota
+	// OpenGL2BufferObjects uploads all draw lists of a frame into one vertex buffer object and one index buffer object,
+	// and draws from these buffers.
+	OpenGL2BufferObjects
+	// OpenGL2VertexArrayObjects draws from buffer objects as OpenGL2BufferObjects does, and keeps the setup of the
+	// vertex arrays in a vertex array object, which leaves the vertex arrays of the host untouched.
+	// Without the ARB_vertex_array_object extension, the renderer falls back to OpenGL2BufferObjects.
+	OpenGL2VertexArrayObjects
+)
+
+// openGL2Buffers hold the buffer objects, and the optional vertex array object, of the OpenGL2 renderer.
+type openGL2Buffers struct {
+	drawListBuffers
+	vertexArray uint32
+}
+
+func newOpenGL2Buffers(vertexArrayObject bool) *openGL2Buffers {
+	buffers := &openGL2Buffers{}
+	buffers.drawListBuffers.create(openGL2Functions)
+	if vertexArrayObject {
+		gl.GenVertexArrays(1, &buffers.vertexArray)
+	}
+	return buffers
+}
+
+func (buffers *openGL2Buffers) delete() {
+	buffers.drawListBuffers.delete()
+	if buffers.vertexArray != 0 {
+		gl.DeleteVertexArrays(1, &buffers.vertexArray)
+		buffers.vertexArray = 0
+	}
+}
+
+// bind binds the vertex array object, if there is one, and the buffers. The binding of the index buffer
+// is part of the vertex array object.
+func (buffers *openGL2Buffers) bind() {
+	if buffers.vertexArray != 0 {
+		gl.BindVertexArray(buffers.vertexArray)
+	}
+	gl.BindBuffer(gl.ARRAY_BUFFER, buffers.vertexBuffer.handle)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, buffers.indexBuffer.handle)
+}
+
+// setOpenGL2VertexOffsets points the vertex arrays to the vertices of a command list in the bound vertex buffer.
+func setOpenGL2VertexOffsets(vertexOffset int) {
+	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
+	gl.VertexPointer(2, gl.FLOAT, int32(vertexSize), gl.PtrOffset(vertexOffset+vertexOffsetPos))
+	gl.TexCoordPointer(2, gl.FLOAT, int32(vertexSize), gl.PtrOffset(vertexOffset+vertexOffsetUv))
+	gl.ColorPointer(4, gl.UNSIGNED_BYTE, int32(vertexSize), gl.PtrOffset(vertexOffset+vertexOffsetCol))
+}
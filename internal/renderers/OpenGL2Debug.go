@@ -0,0 +1,23 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.1/gl"
+)
+
+// EnableDebug forwards the debug output of the context to a logger, see DebugOptions.
+func (renderer *OpenGL2) EnableDebug(options DebugOptions) error {
+	return renderer.debug.enable(openGL2Functions, options)
+}
+
+// openGL2DebugMessageCallback sets the debug callback of the bindings.
+func openGL2DebugMessageCallback(callback func(source, gltype, id, severity uint32, message string)) {
+	gl.DebugMessageCallback(func(source, gltype, id, severity uint32, _ int32, message string, _ unsafe.Pointer) {
+		callback(source, gltype, id, severity, message)
+	}, nil)
+}
+
+func hasOpenGL2Extension(name string) bool {
+	return containsExtension(gl.GoStr(gl.GetString(gl.EXTENSIONS)), name)
+}
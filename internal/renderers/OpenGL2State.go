@@ -0,0 +1,106 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.1/gl"
+)
+
+// OpenGL2State is a snapshot of the OpenGL state that the OpenGL2 renderer changes.
+// Only the groups it was captured with are restored.
+//
+// Blending, capabilities and transformation are saved on the attribute stack of OpenGL,
+// which does not require reading back any state. Captured states must therefore be restored
+// in reverse order of their capture.
+type OpenGL2State struct {
+	groups     StateGroups
+	attribBits uint32
+
+	texture int32
+	program int32
+
+	arrayBuffer        int32
+	elementArrayBuffer int32
+	vertexArray        int32
+	hasVertexArray     bool
+
+	polygonMode [2]int32
+	viewport    [4]int32
+	scissorBox  [4]int32
+}
+
+// CaptureOpenGL2State saves the selected groups of state of the current context.
+// The bound vertex array object is only captured if vertexArray is true, which requires the ARB_vertex_array_object extension.
+func CaptureOpenGL2State(groups StateGroups, vertexArray bool) OpenGL2State {
+	state := OpenGL2State{groups: groups, hasVertexArray: vertexArray}
+
+	if groups.Has(StateTextures) {
+		gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &state.texture)
+	}
+	if groups.Has(StateProgram) {
+		gl.GetIntegerv(gl.CURRENT_PROGRAM, &state.program)
+	}
+	if groups.Has(StateBuffers) {
+		gl.GetIntegerv(gl.ARRAY_BUFFER_BINDING, &state.arrayBuffer)
+		gl.GetIntegerv(gl.ELEMENT_ARRAY_BUFFER_BINDING, &state.elementArrayBuffer)
+		if vertexArray {
+			gl.GetIntegerv(gl.VERTEX_ARRAY_BINDING, &state.vertexArray)
+		}
+	}
+	if groups.Has(StateViewport) {
+		gl.GetIntegerv(gl.POLYGON_MODE, &state.polygonMode[0])
+		gl.GetIntegerv(gl.VIEWPORT, &state.viewport[0])
+		gl.GetIntegerv(gl.SCISSOR_BOX, &state.scissorBox[0])
+	}
+
+	if groups.Has(StateBlend) {
+		state.attribBits |= gl.ENABLE_BIT | gl.COLOR_BUFFER_BIT
+	}
+	if groups.Has(StateCapabilities) {
+		state.attribBits |= gl.ENABLE_BIT
+	}
+	if groups.Has(StateTransform) {
+		state.attribBits |= gl.TRANSFORM_BIT
+	}
+	if state.attribBits != 0 {
+		gl.PushAttrib(state.attribBits)
+	}
+	if groups.Has(StateTransform) {
+		gl.MatrixMode(gl.PROJECTION)
+		gl.PushMatrix()
+		gl.MatrixMode(gl.MODELVIEW)
+		gl.PushMatrix()
+	}
+
+	return state
+}
+
+// Restore writes the captured state back to the current context.
+func (state OpenGL2State) Restore() {
+	if state.groups.Has(StateProgram) {
+		gl.UseProgram(uint32(state.program))
+	}
+	if state.groups.Has(StateTextures) {
+		gl.BindTexture(gl.TEXTURE_2D, uint32(state.texture))
+	}
+	if state.groups.Has(StateBuffers) {
+		if state.hasVertexArray {
+			gl.BindVertexArray(uint32(state.vertexArray))
+		}
+		gl.BindBuffer(gl.ARRAY_BUFFER, uint32(state.arrayBuffer))
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, uint32(state.elementArrayBuffer))
+	}
+	if state.groups.Has(StateTransform) {
+		gl.MatrixMode(gl.MODELVIEW)
+		gl.PopMatrix()
+		gl.MatrixMode(gl.PROJECTION)
+		gl.PopMatrix()
+	}
+	if state.attribBits != 0 {
+		gl.PopAttrib()
+	}
+	if state.groups.Has(StateViewport) {
+		gl.PolygonMode(gl.FRONT, uint32(state.polygonMode[0]))
+		gl.PolygonMode(gl.BACK, uint32(state.polygonMode[1]))
+		gl.Viewport(state.viewport[0], state.viewport[1], state.viewport[2], state.viewport[3])
+		gl.Scissor(state.scissorBox[0], state.scissorBox[1], state.scissorBox[2], state.scissorBox[3])
+	}
+}
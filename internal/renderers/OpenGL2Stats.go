@@ -0,0 +1,64 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.1/gl"
+)
+
+// Stats returns the numbers of the previous frame, see FrameStats. With OpenGL2ClientArrays, UploadBytes counts
+// the bytes that the driver reads from the client-side arrays.
+// The GPU time requires the ARB_timer_query or EXT_timer_query extension.
+func (renderer *OpenGL2) Stats() FrameStats {
+	stats := renderer.stats.previous
+	renderer.timer.addTo(&stats)
+	return stats
+}
+
+// newOpenGL2TimerQueries returns the timer queries of the context, or nil if it does not support them.
+func newOpenGL2TimerQueries() *timerQueries {
+	switch {
+	case hasOpenGL2Extension("GL_ARB_timer_query"):
+		return newTimerQueries(openGL2TimerQueries{})
+	case hasOpenGL2Extension("GL_EXT_timer_query"):
+		return newTimerQueries(openGL2TimerQueries{ext: true})
+	default:
+		return nil
+	}
+}
+
+// openGL2TimerQueries implements timerQueryAPI with the queries of OpenGL 1.5, and the 64-bit results of
+// ARB_timer_query, or those of EXT_timer_query, which have a suffix.
+type openGL2TimerQueries struct {
+	ext bool
+}
+
+func (openGL2TimerQueries) genQueries(queries []uint32) {
+	gl.GenQueries(int32(len(queries)), &queries[0])
+}
+
+func (openGL2TimerQueries) deleteQueries(queries []uint32) {
+	gl.DeleteQueries(int32(len(queries)), &queries[0])
+}
+
+func (openGL2TimerQueries) begin(query uint32) {
+	gl.BeginQuery(gl.TIME_ELAPSED, query)
+}
+
+func (openGL2TimerQueries) end() {
+	gl.EndQuery(gl.TIME_ELAPSED)
+}
+
+func (openGL2TimerQueries) available(query uint32) bool {
+	var available uint32
+	gl.GetQueryObjectuiv(query, gl.QUERY_RESULT_AVAILABLE, &available)
+	return available != 0
+}
+
+func (queries openGL2TimerQueries) result(query uint32) uint64 {
+	var elapsed uint64
+	if queries.ext {
+		gl.GetQueryObjectui64vEXT(query, gl.QUERY_RESULT, &elapsed)
+	} else {
+		gl.GetQueryObjectui64v(query, gl.QUERY_RESULT, &elapsed)
+	}
+	return elapsed
+}
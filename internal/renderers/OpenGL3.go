@@ -1,50 +1,140 @@
 package renderers
 
 import (
-	_ "embed" // using embed for the shader sources
 	"fmt"
+	"image"
 
 	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
 	"github.com/jetsetilly/imgui-go/v5"
 )
 
-//go:embed gl-shader/main.vert
-var unversionedVertexShader string
-
-//go:embed gl-shader/main.frag
-var unversionedFragmentShader string
-
 // OpenGL3 implements a renderer based on github.com/go-gl/gl (v3.2-core).
 type OpenGL3 struct {
 	imguiIO imgui.IO
 
 	glslVersion            string
 	fontTexture            uint32
-	shaderHandle           uint32
-	vertHandle             uint32
-	fragHandle             uint32
+	fontFormat             FontAtlasFormat
+	fontImage              fontAtlasImage
+	program                shaderProgram
 	attribLocationTex      int32
 	attribLocationProjMtx  int32
+	attribLocationAlpha    int32
+	attribLocationLinear   int32
 	attribLocationPosition int32
 	attribLocationUV       int32
 	attribLocationColor    int32
-	vboHandle              uint32
-	elementsHandle         uint32
+	buffers                drawListBuffers
+	textures               userTextures
+	offscreenTargets       map[*OffscreenTarget]struct{}
+	stateGroups            StateGroups
+	debug                  debugOutput
+	vertexArrays           map[interface{}]uint32
+	currentContext         interface{}
+	callbacks              *DrawCallbacks
+	sdf                    *openGL3SDF
+	srgb                   bool
+	transparent            bool
+	stats                  frameStats
+	timer                  *timerQueries
+
+	viewport drawViewport
+}
+
+// openGL3Functions are the functions of the bindings of the OpenGL3 renderer, for shared code.
+var openGL3Functions = &glFunctions{
+	GetIntegerv: gl.GetIntegerv,
+	IsEnabled:   gl.IsEnabled,
+	Enable:      gl.Enable,
+	Disable:     gl.Disable,
+	BindBuffer:  gl.BindBuffer,
+
+	GenBuffers:     gl.GenBuffers,
+	DeleteBuffers:  gl.DeleteBuffers,
+	BufferData:     gl.BufferData,
+	BufferSubData:  gl.BufferSubData,
+	MapBufferRange: gl.MapBufferRange,
+	UnmapBuffer:    gl.UnmapBuffer,
+
+	ActiveTexture:         gl.ActiveTexture,
+	BlendEquationSeparate: gl.BlendEquationSeparate,
+	BlendFuncSeparate:     gl.BlendFuncSeparate,
+	Viewport:              gl.Viewport,
+	Scissor:               gl.Scissor,
+
+	Str:                gl.Str,
+	Strs:               gl.Strs,
+	CreateProgram:      gl.CreateProgram,
+	DeleteProgram:      gl.DeleteProgram,
+	CreateShader:       gl.CreateShader,
+	DeleteShader:       gl.DeleteShader,
+	AttachShader:       gl.AttachShader,
+	DetachShader:       gl.DetachShader,
+	ShaderSource:       gl.ShaderSource,
+	CompileShader:      gl.CompileShader,
+	GetShaderiv:        gl.GetShaderiv,
+	GetShaderInfoLog:   gl.GetShaderInfoLog,
+	BindAttribLocation: gl.BindAttribLocation,
+	LinkProgram:        gl.LinkProgram,
+	GetProgramiv:       gl.GetProgramiv,
+	GetProgramInfoLog:  gl.GetProgramInfoLog,
+	GetUniformLocation: gl.GetUniformLocation,
+	GetAttribLocation:  gl.GetAttribLocation,
+	UseProgram:         gl.UseProgram,
+
+	GenTextures:    gl.GenTextures,
+	DeleteTextures: gl.DeleteTextures,
+	BindTexture:    gl.BindTexture,
+	TexParameteri:  gl.TexParameteri,
+	PixelStorei:    gl.PixelStorei,
+	TexImage2D:     gl.TexImage2D,
+	TexSubImage2D:  gl.TexSubImage2D,
+	ReadPixels:     gl.ReadPixels,
+
+	HasExtension:         hasOpenGL3Extension,
+	DebugMessageCallback: openGL3DebugMessageCallback,
+	PushDebugGroup:       gl.PushDebugGroup,
+	PopDebugGroup:        gl.PopDebugGroup,
 }
 
-// NewOpenGL3 attempts to initialize a renderer.
+// NewOpenGL3 attempts to initialize a renderer, with shaders matching the GLSL version of the context.
 // An OpenGL context has to be established before calling this function.
 func NewOpenGL3(io imgui.IO) (*OpenGL3, error) {
+	return NewOpenGL3V(io, "")
+}
+
+// NewOpenGL3V attempts to initialize a renderer, with shaders for the given #version directive, e.g. GLSLVersion330.
+// An empty string selects the version based on the GLSL version the context reports.
+// An OpenGL context has to be established before calling this function.
+func NewOpenGL3V(io imgui.IO, glslVersion string) (*OpenGL3, error) {
 	err := gl.Init()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize OpenGL: %w", err)
 	}
 
+	if glslVersion == "" {
+		number, es, err := parseGLSLVersion(gl.GoStr(gl.GetString(gl.SHADING_LANGUAGE_VERSION)))
+		if err != nil {
+			return nil, err
+		}
+		glslVersion = glslVersionFor(number, es)
+	}
+
 	renderer := &OpenGL3{
 		imguiIO:     io,
-		glslVersion: "#version 150",
+		glslVersion: glslVersion,
+		textures:    newUserTextures(openGL3Functions),
+		stateGroups: StateAll,
+		callbacks:   newDrawCallbacks(),
+
+		offscreenTargets: make(map[*OffscreenTarget]struct{}),
+		vertexArrays:     make(map[interface{}]uint32),
+	}
+	err = renderer.createDeviceObjects()
+	if err != nil {
+		renderer.invalidateDeviceObjects()
+		return nil, err
 	}
-	renderer.createDeviceObjects()
 
 	io.SetBackendFlags(io.GetBackendFlags() | imgui.BackendFlagsRendererHasVtxOffset)
 
@@ -53,105 +143,123 @@ func NewOpenGL3(io imgui.IO) (*OpenGL3, error) {
 
 // Dispose cleans up the resources.
 func (renderer *OpenGL3) Dispose() {
+	renderer.disposeOffscreenTargets()
+	renderer.textures.freeAll()
 	renderer.invalidateDeviceObjects()
 }
 
-// PreRender clears the framebuffer.
-func (renderer *OpenGL3) PreRender(clearColor [3]float32) {
-	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], 1.0)
+// NewFrame prepares the renderer for a new frame and must be called before imgui.NewFrame().
+// It clears the draw callbacks of the previous frame, and recreates the font texture if the font atlas was rebuilt.
+func (renderer *OpenGL3) NewFrame() {
+	renderer.callbacks.clear()
+	renderer.stats.nextFrame()
+
+	if fontAtlasRebuilt(renderer.imguiIO.Fonts(), renderer.fontFormat, renderer.fontTexture, renderer.fontImage) {
+		renderer.RebuildFontsTexture()
+	}
+}
+
+// RebuildFontsTexture replaces the font texture with one created from the current state of the font atlas.
+// The new texture is stored as the texture ID of the atlas. Any previous texture ID becomes invalid.
+// It must be called after fonts have been added to or removed from the atlas, and not between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGL3) RebuildFontsTexture() {
+	renderer.destroyFontsTexture()
+	renderer.createFontsTexture()
+	if renderer.sdf != nil {
+		renderer.sdf.deleteTexture()
+		renderer.createSDFTexture()
+	}
+}
+
+// GLSLVersion returns the #version directive the shaders of the renderer were compiled with.
+func (renderer *OpenGL3) GLSLVersion() string {
+	return renderer.glslVersion
+}
+
+// SetStateBackup selects the groups of OpenGL state that Render() saves before rendering and restores afterwards.
+// The default is StateAll. Hosts that set up all of their state themselves can pass StateNone.
+func (renderer *OpenGL3) SetStateBackup(groups StateGroups) {
+	renderer.stateGroups = groups
+}
+
+// SetFontAtlasFormat selects the pixel format of the font texture, and recreates the texture if the format changes.
+// The default is FontAtlasAlpha8. It must not be called between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGL3) SetFontAtlasFormat(format FontAtlasFormat) {
+	if format == renderer.fontFormat {
+		return
+	}
+	renderer.fontFormat = format
+	renderer.RebuildFontsTexture()
+}
+
+// DrawCallbacks returns the registry of the functions that are called while rendering the current frame.
+func (renderer *OpenGL3) DrawCallbacks() *DrawCallbacks {
+	return renderer.callbacks
+}
+
+// SetTransparent selects whether the framebuffer is the one of a transparent window, see platforms.Options.
+// Compositors expect premultiplied alpha from such windows, so the clear color is then premultiplied, and the alpha
+// channel is blended separately. The default is false, for opaque windows.
+func (renderer *OpenGL3) SetTransparent(transparent bool) {
+	renderer.transparent = transparent
+}
+
+// PreRender clears the framebuffer. The clear color has straight alpha. It is written as given,
+// or with premultiplied alpha if the framebuffer is transparent, see SetTransparent().
+func (renderer *OpenGL3) PreRender(clearColor [4]float32) {
+	if renderer.srgb {
+		// The clear color is given in sRGB, as the colors of imgui are, so it is written without encoding.
+		gl.Disable(gl.FRAMEBUFFER_SRGB)
+	}
+	if renderer.transparent {
+		clearColor = [4]float32{clearColor[0] * clearColor[3], clearColor[1] * clearColor[3], clearColor[2] * clearColor[3], clearColor[3]}
+	}
+	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
 	gl.Clear(gl.COLOR_BUFFER_BIT)
 }
 
 // Render translates the ImGui draw data to OpenGL3 commands.
-func (renderer *OpenGL3) Render(displaySize [2]float32, framebufferSize [2]float32, drawData imgui.DrawData) {
-	// Avoid rendering when minimized, scale coordinates for retina displays (screen coordinates != framebuffer coordinates)
-	displayWidth, displayHeight := displaySize[0], displaySize[1]
-	fbWidth, fbHeight := framebufferSize[0], framebufferSize[1]
-	if (fbWidth <= 0) || (fbHeight <= 0) {
+// The display position, display size, and framebuffer scale of the draw data determine the area that is rendered.
+func (renderer *OpenGL3) Render(drawData imgui.DrawData) {
+	renderer.viewport = newDrawViewport(drawData)
+	renderer.timer.begin()
+	renderer.render(renderer.viewport, drawData, renderTarget{srgb: renderer.srgb, premultiplied: renderer.transparent, counted: true})
+	renderer.timer.end()
+}
+
+// renderTarget describes the framebuffer that render() draws into.
+type renderTarget struct {
+	// srgb is set if the framebuffer has to encode colors to sRGB.
+	srgb bool
+	// premultiplied is set if the framebuffer has to hold premultiplied alpha, as offscreen targets and
+	// transparent windows do.
+	premultiplied bool
+	// counted is set if the drawing adds to Stats(), which only Render() does.
+	counted bool
+}
+
+// render draws into the currently bound framebuffer.
+func (renderer *OpenGL3) render(viewport drawViewport, drawData imgui.DrawData, target renderTarget) {
+	// Avoid rendering when minimized
+	if viewport.empty() {
 		return
 	}
-	drawData.ScaleClipRects(imgui.Vec2{
-		X: fbWidth / displayWidth,
-		Y: fbHeight / displayHeight,
-	})
+
+	renderer.debug.pushGroup("imgui")
 
 	// Backup GL state
-	var lastActiveTexture int32
-	gl.GetIntegerv(gl.ACTIVE_TEXTURE, &lastActiveTexture)
-	gl.ActiveTexture(gl.TEXTURE0)
-	var lastProgram int32
-	gl.GetIntegerv(gl.CURRENT_PROGRAM, &lastProgram)
-	var lastTexture int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
-	var lastSampler int32
-	gl.GetIntegerv(gl.SAMPLER_BINDING, &lastSampler)
-	var lastArrayBuffer int32
-	gl.GetIntegerv(gl.ARRAY_BUFFER_BINDING, &lastArrayBuffer)
-	var lastElementArrayBuffer int32
-	gl.GetIntegerv(gl.ELEMENT_ARRAY_BUFFER_BINDING, &lastElementArrayBuffer)
-	var lastVertexArray int32
-	gl.GetIntegerv(gl.VERTEX_ARRAY_BINDING, &lastVertexArray)
-	var lastPolygonMode [2]int32
-	gl.GetIntegerv(gl.POLYGON_MODE, &lastPolygonMode[0])
-	var lastViewport [4]int32
-	gl.GetIntegerv(gl.VIEWPORT, &lastViewport[0])
-	var lastScissorBox [4]int32
-	gl.GetIntegerv(gl.SCISSOR_BOX, &lastScissorBox[0])
-	var lastBlendSrcRgb int32
-	gl.GetIntegerv(gl.BLEND_SRC_RGB, &lastBlendSrcRgb)
-	var lastBlendDstRgb int32
-	gl.GetIntegerv(gl.BLEND_DST_RGB, &lastBlendDstRgb)
-	var lastBlendSrcAlpha int32
-	gl.GetIntegerv(gl.BLEND_SRC_ALPHA, &lastBlendSrcAlpha)
-	var lastBlendDstAlpha int32
-	gl.GetIntegerv(gl.BLEND_DST_ALPHA, &lastBlendDstAlpha)
-	var lastBlendEquationRgb int32
-	gl.GetIntegerv(gl.BLEND_EQUATION_RGB, &lastBlendEquationRgb)
-	var lastBlendEquationAlpha int32
-	gl.GetIntegerv(gl.BLEND_EQUATION_ALPHA, &lastBlendEquationAlpha)
-	lastEnableBlend := gl.IsEnabled(gl.BLEND)
-	lastEnableCullFace := gl.IsEnabled(gl.CULL_FACE)
-	lastEnableDepthTest := gl.IsEnabled(gl.DEPTH_TEST)
-	lastEnableScissorTest := gl.IsEnabled(gl.SCISSOR_TEST)
-
-	// Setup render state: alpha-blending enabled, no face culling, no depth testing, scissor enabled, polygon fill
-	gl.Enable(gl.BLEND)
-	gl.BlendEquation(gl.FUNC_ADD)
-	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
-	gl.Disable(gl.CULL_FACE)
-	gl.Disable(gl.DEPTH_TEST)
-	gl.Enable(gl.SCISSOR_TEST)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
+	lastState := CaptureOpenGL3State(renderer.stateGroups)
 
-	// Setup viewport, orthographic projection matrix
-	// Our visible imgui space lies from draw_data->DisplayPos (top left) to draw_data->DisplayPos+data_data->DisplaySize (bottom right).
-	// DisplayMin is typically (0,0) for single viewport apps.
-	gl.Viewport(0, 0, int32(fbWidth), int32(fbHeight))
-	orthoProjection := [4][4]float32{
-		{2.0 / displayWidth, 0.0, 0.0, 0.0},
-		{0.0, 2.0 / -displayHeight, 0.0, 0.0},
-		{0.0, 0.0, -1.0, 0.0},
-		{-1.0, 1.0, 0.0, 1.0},
+	renderer.setupRenderState(viewport, target)
+	stats := &FrameStats{}
+	if target.counted {
+		stats = &renderer.stats.current
 	}
-	gl.UseProgram(renderer.shaderHandle)
-	gl.Uniform1i(renderer.attribLocationTex, 0)
-	gl.UniformMatrix4fv(renderer.attribLocationProjMtx, 1, false, &orthoProjection[0][0])
-	gl.BindSampler(0, 0) // Rely on combined texture/sampler state.
+	tracker := newDrawTracker(stats)
+	alphaTexture := false
+	sdfSection := false
+	sdfText := false
 
-	// Recreate the VAO every time
-	// (This is to easily allow multiple GL contexts. VAO are not shared among GL contexts, and
-	// we don't track creation/deletion of windows so we don't have an obvious key to use to cache them.)
-	var vaoHandle uint32
-	gl.GenVertexArrays(1, &vaoHandle)
-	gl.BindVertexArray(vaoHandle)
-	gl.BindBuffer(gl.ARRAY_BUFFER, renderer.vboHandle)
-	gl.EnableVertexAttribArray(uint32(renderer.attribLocationPosition))
-	gl.EnableVertexAttribArray(uint32(renderer.attribLocationUV))
-	gl.EnableVertexAttribArray(uint32(renderer.attribLocationColor))
-	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
-	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationPosition), 2, gl.FLOAT, false, int32(vertexSize), uintptr(vertexOffsetPos))
-	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationUV), 2, gl.FLOAT, false, int32(vertexSize), uintptr(vertexOffsetUv))
-	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationColor), 4, gl.UNSIGNED_BYTE, true, int32(vertexSize), uintptr(vertexOffsetCol))
 	indexSize := imgui.IndexBufferLayout()
 	drawType := gl.UNSIGNED_SHORT
 	const bytesPerUint32 = 4
@@ -160,65 +268,103 @@ func (renderer *OpenGL3) Render(displaySize [2]float32, framebufferSize [2]float
 	}
 
 	// Draw
-	for _, list := range drawData.CommandLists() {
-		vertexBuffer, vertexBufferSize := list.VertexBuffer()
-		gl.BindBuffer(gl.ARRAY_BUFFER, renderer.vboHandle)
-		gl.BufferData(gl.ARRAY_BUFFER, vertexBufferSize, vertexBuffer, gl.STREAM_DRAW)
-
-		indexBuffer, indexBufferSize := list.IndexBuffer()
-		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, renderer.elementsHandle)
-		gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, indexBufferSize, indexBuffer, gl.STREAM_DRAW)
-
+	lists := drawData.CommandLists()
+	if renderer.sdf != nil {
+		renderer.prepareSDF(lists)
+	}
+	renderer.buffers.upload(lists, renderer.drawListVertices, drawListIndexBuffer, stats)
+	for listIndex, list := range lists {
+		offsets := renderer.buffers.listOffsets[listIndex]
 		for _, cmd := range list.Commands() {
 			if cmd.HasUserCallback() {
 				cmd.CallUserCallback(list)
+				tracker.forget()
+			} else if cmd.TextureID() == resetRenderStateTextureID {
+				renderer.setupRenderState(viewport, target)
+				tracker.forget()
+				alphaTexture = false
+				sdfText = false
+			} else if cmd.TextureID() == beginSDFTextureID {
+				sdfSection = renderer.sdf != nil
+			} else if cmd.TextureID() == endSDFTextureID {
+				sdfSection = false
 			} else {
-				gl.BindTexture(gl.TEXTURE_2D, uint32(cmd.TextureID()))
-				clipRect := cmd.ClipRect()
-				gl.Scissor(int32(clipRect.X), int32(fbHeight)-int32(clipRect.W), int32(clipRect.Z-clipRect.X), int32(clipRect.W-clipRect.Y))
+				x, y, width, height, visible := viewport.scissor(cmd.ClipRect())
+				if !visible {
+					continue
+				}
+				if tracker.setScissor([4]int32{x, y, width, height}) {
+					gl.Scissor(x, y, width, height)
+				}
+				if callback, isCallback := renderer.callbacks.lookup(cmd.TextureID()); isCallback {
+					callback.call(viewport, cmd.ClipRect(), [4]int32{x, y, width, height})
+					tracker.forget()
+					continue
+				}
+				texture := uint32(cmd.TextureID())
+				if isSDF := sdfSection && (texture == renderer.fontTexture); isSDF != sdfText {
+					sdfText = isSDF
+					if sdfText {
+						renderer.useSDFProgram(viewport, target.srgb)
+					} else {
+						gl.UseProgram(renderer.program.handle)
+					}
+				}
+				if sdfText {
+					texture = renderer.sdf.texture
+				} else if isAlpha := renderer.isAlphaTexture(cmd.TextureID()); isAlpha != alphaTexture {
+					alphaTexture = isAlpha
+					gl.Uniform1i(renderer.attribLocationAlpha, boolToInt32(alphaTexture))
+				}
+				if tracker.bindTexture(texture) {
+					gl.BindTexture(gl.TEXTURE_2D, texture)
+				}
+				tracker.draw(cmd.ElementCount())
 				gl.DrawElementsBaseVertexWithOffset(gl.TRIANGLES, int32(cmd.ElementCount()), uint32(drawType),
-					uintptr(cmd.IndexOffset()*indexSize), int32(cmd.VertexOffset()))
+					uintptr(offsets.indexOffset+cmd.IndexOffset()*indexSize), int32(offsets.baseVertex+cmd.VertexOffset()))
 			}
 		}
 	}
-	gl.DeleteVertexArrays(1, &vaoHandle)
 
 	// Restore modified GL state
-	gl.UseProgram(uint32(lastProgram))
-	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
-	gl.BindSampler(0, uint32(lastSampler))
-	gl.ActiveTexture(uint32(lastActiveTexture))
-	gl.BindVertexArray(uint32(lastVertexArray))
-	gl.BindBuffer(gl.ARRAY_BUFFER, uint32(lastArrayBuffer))
-	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, uint32(lastElementArrayBuffer))
-	gl.BlendEquationSeparate(uint32(lastBlendEquationRgb), uint32(lastBlendEquationAlpha))
-	gl.BlendFuncSeparate(uint32(lastBlendSrcRgb), uint32(lastBlendDstRgb), uint32(lastBlendSrcAlpha), uint32(lastBlendDstAlpha))
-	if lastEnableBlend {
-		gl.Enable(gl.BLEND)
-	} else {
-		gl.Disable(gl.BLEND)
-	}
-	if lastEnableCullFace {
-		gl.Enable(gl.CULL_FACE)
-	} else {
-		gl.Disable(gl.CULL_FACE)
-	}
-	if lastEnableDepthTest {
-		gl.Enable(gl.DEPTH_TEST)
-	} else {
-		gl.Disable(gl.DEPTH_TEST)
-	}
-	if lastEnableScissorTest {
-		gl.Enable(gl.SCISSOR_TEST)
+	lastState.Restore()
+	renderer.debug.popGroup()
+}
+
+// setupRenderState sets the state for rendering the draw data. It is called at the start of rendering,
+// and again for each command added by DrawCallbacks.AddResetRenderState().
+func (renderer *OpenGL3) setupRenderState(viewport drawViewport, target renderTarget) {
+	// Setup render state: alpha-blending enabled, no face culling, no depth testing, scissor enabled, polygon fill,
+	// and sRGB encoding as requested
+	gl.ActiveTexture(gl.TEXTURE0)
+	gl.Enable(gl.BLEND)
+	gl.BlendEquation(gl.FUNC_ADD)
+	if target.premultiplied {
+		// The alpha channel is blended separately, so that rendering into a transparent target results in premultiplied alpha.
+		gl.BlendFuncSeparate(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA)
 	} else {
-		gl.Disable(gl.SCISSOR_TEST)
+		gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
 	}
-	gl.PolygonMode(gl.FRONT_AND_BACK, uint32(lastPolygonMode[0]))
-	gl.Viewport(lastViewport[0], lastViewport[1], lastViewport[2], lastViewport[3])
-	gl.Scissor(lastScissorBox[0], lastScissorBox[1], lastScissorBox[2], lastScissorBox[3])
+	gl.Disable(gl.CULL_FACE)
+	gl.Disable(gl.DEPTH_TEST)
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
+	setCapability(openGL3Functions, gl.FRAMEBUFFER_SRGB, target.srgb)
+
+	// Setup viewport, orthographic projection matrix
+	gl.Viewport(0, 0, int32(viewport.framebufferSize.X), int32(viewport.framebufferSize.Y))
+	orthoProjection := viewport.orthoProjection()
+	gl.UseProgram(renderer.program.handle)
+	gl.Uniform1i(renderer.attribLocationTex, 0)
+	gl.UniformMatrix4fv(renderer.attribLocationProjMtx, 1, false, &orthoProjection[0][0])
+	gl.Uniform1i(renderer.attribLocationAlpha, 0)
+	gl.Uniform1i(renderer.attribLocationLinear, boolToInt32(target.srgb))
+	gl.BindSampler(0, 0) // Rely on combined texture/sampler state.
+
+	renderer.bindVertexArray()
 }
 
-func (renderer *OpenGL3) createDeviceObjects() {
+func (renderer *OpenGL3) createDeviceObjects() error {
 	// Backup GL state
 	var lastTexture int32
 	var lastArrayBuffer int32
@@ -226,103 +372,103 @@ func (renderer *OpenGL3) createDeviceObjects() {
 	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
 	gl.GetIntegerv(gl.ARRAY_BUFFER_BINDING, &lastArrayBuffer)
 	gl.GetIntegerv(gl.VERTEX_ARRAY_BINDING, &lastVertexArray)
+	defer func() {
+		// Restore modified GL state
+		gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+		gl.BindBuffer(gl.ARRAY_BUFFER, uint32(lastArrayBuffer))
+		gl.BindVertexArray(uint32(lastVertexArray))
+	}()
+
+	vertexShader, fragmentShader, err := shaderSources(renderer.glslVersion)
+	if err != nil {
+		return err
+	}
 
-	vertexShader := renderer.glslVersion + "\n" + unversionedVertexShader
-	fragmentShader := renderer.glslVersion + "\n" + unversionedFragmentShader
-
-	renderer.shaderHandle = gl.CreateProgram()
-	renderer.vertHandle = gl.CreateShader(gl.VERTEX_SHADER)
-	renderer.fragHandle = gl.CreateShader(gl.FRAGMENT_SHADER)
-
-	glShaderSource := func(handle uint32, source string) {
-		csource, free := gl.Strs(source + "\x00")
-		defer free()
-
-		gl.ShaderSource(handle, 1, csource, nil)
+	err = renderer.program.create(openGL3Functions, vertexShader, fragmentShader, "fragment shader", nil)
+	if err != nil {
+		return err
+	}
+	err = renderer.program.lookupLocations(openGL3Functions, []shaderLocation{
+		{&renderer.attribLocationTex, uniformLocation, "Texture"},
+		{&renderer.attribLocationProjMtx, uniformLocation, "ProjMtx"},
+		{&renderer.attribLocationAlpha, uniformLocation, "AlphaTexture"},
+		{&renderer.attribLocationLinear, uniformLocation, "LinearColor"},
+		{&renderer.attribLocationPosition, attribLocation, "Position"},
+		{&renderer.attribLocationUV, attribLocation, "UV"},
+		{&renderer.attribLocationColor, attribLocation, "Color"},
+	})
+	if err != nil {
+		return err
 	}
 
-	glShaderSource(renderer.vertHandle, vertexShader)
-	glShaderSource(renderer.fragHandle, fragmentShader)
-	gl.CompileShader(renderer.vertHandle)
-	gl.CompileShader(renderer.fragHandle)
-	gl.AttachShader(renderer.shaderHandle, renderer.vertHandle)
-	gl.AttachShader(renderer.shaderHandle, renderer.fragHandle)
-	gl.LinkProgram(renderer.shaderHandle)
+	renderer.buffers.create(openGL3Functions)
 
-	renderer.attribLocationTex = gl.GetUniformLocation(renderer.shaderHandle, gl.Str("Texture"+"\x00"))
-	renderer.attribLocationProjMtx = gl.GetUniformLocation(renderer.shaderHandle, gl.Str("ProjMtx"+"\x00"))
-	renderer.attribLocationPosition = gl.GetAttribLocation(renderer.shaderHandle, gl.Str("Position"+"\x00"))
-	renderer.attribLocationUV = gl.GetAttribLocation(renderer.shaderHandle, gl.Str("UV"+"\x00"))
-	renderer.attribLocationColor = gl.GetAttribLocation(renderer.shaderHandle, gl.Str("Color"+"\x00"))
+	renderer.createFontsTexture()
 
-	gl.GenBuffers(1, &renderer.vboHandle)
-	gl.GenBuffers(1, &renderer.elementsHandle)
+	if hasOpenGL3TimerQueries() {
+		renderer.timer = newTimerQueries(openGL3TimerQueries{})
+	}
 
-	renderer.createFontsTexture()
+	return nil
+}
 
-	// Restore modified GL state
-	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
-	gl.BindBuffer(gl.ARRAY_BUFFER, uint32(lastArrayBuffer))
-	gl.BindVertexArray(uint32(lastVertexArray))
+// Capture reads back the frame that was last rendered. It must be called after Render() and before the
+// display buffer is swapped, as the content of the buffer is undefined afterwards.
+// The region is given in display coordinates, the same as imgui uses for window positions. An empty region captures
+// the whole frame. The returned image has the size of the captured region in framebuffer pixels.
+//
+// The alpha channel is the one of the framebuffer, with premultiplied alpha, as image.RGBA expects. It is opaque unless
+// the clear color, or what was drawn, is transparent, and the framebuffer has an alpha channel.
+func (renderer *OpenGL3) Capture(region image.Rectangle) (*image.RGBA, error) {
+	return captureFramebuffer(openGL3Functions, region, renderer.viewport, false)
 }
 
 func (renderer *OpenGL3) createFontsTexture() {
 	// Build texture atlas
 	io := imgui.CurrentIO()
-	image := io.Fonts().TextureDataAlpha8()
+	image := currentFontAtlasImage(io.Fonts(), renderer.fontFormat)
 
 	// Upload texture to graphics system
-	var lastTexture int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
-	gl.GenTextures(1, &renderer.fontTexture)
-	gl.BindTexture(gl.TEXTURE_2D, renderer.fontTexture)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	// The Alpha8 atlas only has a red channel, which the shader reads as coverage, see isAlphaTexture().
+	internalFormat, format := int32(gl.RED), uint32(gl.RED)
+	if image.format == FontAtlasRGBA32 {
+		internalFormat, format = renderer.colorTextureFormat(), gl.RGBA
+	}
+	var lastUnpackRowLength int32
+	gl.GetIntegerv(gl.UNPACK_ROW_LENGTH, &lastUnpackRowLength)
 	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RED, int32(image.Width), int32(image.Height),
-		0, gl.RED, gl.UNSIGNED_BYTE, image.Pixels)
+	renderer.fontTexture = createFontTexture(openGL3Functions, image, internalFormat, format)
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, lastUnpackRowLength)
+	renderer.fontImage = image
 
 	// Store our identifier
 	io.Fonts().SetTextureID(imgui.TextureID(renderer.fontTexture))
+}
 
-	// Restore state
-	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+// isAlphaTexture returns true if the texture is the font atlas in the Alpha8 format, which the shader reads as coverage.
+// All other textures, including the font atlas in the RGBA32 format, are multiplied with the vertex color.
+func (renderer *OpenGL3) isAlphaTexture(id imgui.TextureID) bool {
+	return (renderer.fontFormat == FontAtlasAlpha8) && (uint32(id) == renderer.fontTexture)
 }
 
 func (renderer *OpenGL3) invalidateDeviceObjects() {
-	if renderer.vboHandle != 0 {
-		gl.DeleteBuffers(1, &renderer.vboHandle)
-	}
-	renderer.vboHandle = 0
-	if renderer.elementsHandle != 0 {
-		gl.DeleteBuffers(1, &renderer.elementsHandle)
-	}
-	renderer.elementsHandle = 0
+	renderer.DisableSDF()
+	renderer.deleteVertexArrays()
 
-	if (renderer.shaderHandle != 0) && (renderer.vertHandle != 0) {
-		gl.DetachShader(renderer.shaderHandle, renderer.vertHandle)
-	}
-	if renderer.vertHandle != 0 {
-		gl.DeleteShader(renderer.vertHandle)
-	}
-	renderer.vertHandle = 0
+	renderer.buffers.delete()
+	renderer.timer.delete()
+	renderer.timer = nil
 
-	if (renderer.shaderHandle != 0) && (renderer.fragHandle != 0) {
-		gl.DetachShader(renderer.shaderHandle, renderer.fragHandle)
-	}
-	if renderer.fragHandle != 0 {
-		gl.DeleteShader(renderer.fragHandle)
-	}
-	renderer.fragHandle = 0
+	renderer.program.delete(openGL3Functions)
 
-	if renderer.shaderHandle != 0 {
-		gl.DeleteProgram(renderer.shaderHandle)
-	}
-	renderer.shaderHandle = 0
+	renderer.destroyFontsTexture()
+}
 
+func (renderer *OpenGL3) destroyFontsTexture() {
 	if renderer.fontTexture != 0 {
 		gl.DeleteTextures(1, &renderer.fontTexture)
 		imgui.CurrentIO().Fonts().SetTextureID(0)
 		renderer.fontTexture = 0
+		renderer.fontImage = fontAtlasImage{}
 	}
 }
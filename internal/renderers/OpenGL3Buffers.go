@@ -0,0 +1,39 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// BufferStrategy selects how the OpenGL3 renderer uploads vertices and indices to the GL buffers.
+// Independent of the strategy, all command lists of a frame are uploaded into one vertex buffer and one index buffer,
+// which only grow when a frame does not fit into them.
+type BufferStrategy int
+
+// This is a list of BufferStrategy constants.
+const (
+	// BufferOrphan re-specifies the storage of the buffers before writing into them, so that the driver can hand out
+	// fresh memory while the previous frame is still being drawn from the old one. This is the default.
+	BufferOrphan BufferStrategy = iota
+	// BufferSubData writes into the existing storage of the buffers. The driver may have to wait for
+	// the previous frame to finish drawing.
+	BufferSubData
+	// BufferMapRange maps the buffers, invalidating their content, and copies the command lists into them directly.
+	BufferMapRange
+)
+
+// SetBufferStrategy selects how vertices and indices are uploaded. The default is BufferOrphan.
+func (renderer *OpenGL3) SetBufferStrategy(strategy BufferStrategy) {
+	renderer.buffers.strategy = strategy
+}
+
+// drawListVertices returns the vertices of the list as they are uploaded. These are the ones of imgui,
+// unless they were rewritten for text drawn from the distance field, see prepareSDF().
+func (renderer *OpenGL3) drawListVertices(listIndex int, list imgui.DrawList) (unsafe.Pointer, int) {
+	if (renderer.sdf != nil) && (len(renderer.sdf.vertices[listIndex]) > 0) {
+		vertices := renderer.sdf.vertices[listIndex]
+		return unsafe.Pointer(&vertices[0]), len(vertices)
+	}
+	return list.VertexBuffer()
+}
@@ -0,0 +1,162 @@
+package renderers
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// mainThread receives the functions that have to run on the main thread.
+var mainThread = make(chan func())
+
+// TestMain runs the tests in their own goroutine, while the main goroutine stays on the main thread
+// and runs the functions given to onMainThread(). GLFW and OpenGL require to be called from the main thread.
+func TestMain(m *testing.M) {
+	runtime.LockOSThread()
+	done := make(chan int)
+	go func() {
+		done <- m.Run()
+	}()
+	for {
+		select {
+		case f := <-mainThread:
+			f()
+		case code := <-done:
+			os.Exit(code)
+		}
+	}
+}
+
+// onMainThread runs f on the main thread, and returns once it finished.
+func onMainThread(f func()) {
+	finished := make(chan struct{})
+	mainThread <- func() {
+		defer close(finished)
+		f()
+	}
+	<-finished
+}
+
+// The benchmarks require a display to create a hidden window with an OpenGL 3.2 context. They are skipped otherwise.
+// Run them with: go test -run XXX -bench . ./internal/renderers
+
+const (
+	benchmarkWindows        = 8
+	benchmarkRectsPerWindow = 10000
+)
+
+// runBenchmark creates a renderer in a hidden window, and draw data of benchmarkWindows command lists with
+// four vertices per rectangle each. It then runs the benchmark with the command lists, on the main thread.
+func runBenchmark(b *testing.B, run func(renderer *OpenGL3, lists []imgui.DrawList)) {
+	b.Helper()
+
+	var skip, err error
+	onMainThread(func() {
+		if skip = glfw.Init(); skip != nil {
+			return
+		}
+		defer glfw.Terminate()
+
+		glfw.WindowHint(glfw.Visible, glfw.False)
+		glfw.WindowHint(glfw.ContextVersionMajor, 3)
+		glfw.WindowHint(glfw.ContextVersionMinor, 2)
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, 1)
+		window, windowErr := glfw.CreateWindow(1920, 1080, "benchmark", nil, nil)
+		if windowErr != nil {
+			skip = windowErr
+			return
+		}
+		defer window.Destroy()
+		window.MakeContextCurrent()
+
+		context := imgui.CreateContext(nil)
+		defer context.Destroy()
+		io := imgui.CurrentIO()
+		io.SetDisplaySize(imgui.Vec2{X: 1920, Y: 1080})
+		io.SetDeltaTime(1.0 / 60.0)
+
+		renderer, rendererErr := NewOpenGL3(io)
+		if rendererErr != nil {
+			err = rendererErr
+			return
+		}
+		defer renderer.Dispose()
+
+		imgui.NewFrame()
+		for index := 0; index < benchmarkWindows; index++ {
+			imgui.SetNextWindowPosV(imgui.Vec2{X: float32(index * 200), Y: 0}, imgui.ConditionAlways, imgui.Vec2{})
+			imgui.SetNextWindowSizeV(imgui.Vec2{X: 200, Y: 1000}, imgui.ConditionAlways)
+			imgui.Begin(fmt.Sprintf("window %d", index))
+			drawList := imgui.WindowDrawList()
+			for rect := 0; rect < benchmarkRectsPerWindow; rect++ {
+				x := float32(index*200 + rect%200)
+				y := float32(rect % 1000)
+				drawList.AddRectFilled(imgui.Vec2{X: x, Y: y}, imgui.Vec2{X: x + 1, Y: y + 1}, imgui.PackedColor(0xFFFFFFFF))
+			}
+			imgui.End()
+		}
+		imgui.Render()
+
+		lists := imgui.RenderedDrawData().CommandLists()
+		renderer.bindVertexArray()
+		b.ResetTimer()
+		run(renderer, lists)
+	})
+	if skip != nil {
+		b.Skipf("no display with an OpenGL 3.2 context: %v", skip)
+	}
+	if err != nil {
+		b.Fatalf("failed to create renderer: %v", err)
+	}
+}
+
+// uploadPerList is the upload of the renderer before the introduction of the shared buffers:
+// each command list is uploaded with a new allocation of the buffer storage.
+func uploadPerList(renderer *OpenGL3, lists []imgui.DrawList) {
+	for _, list := range lists {
+		vertexBuffer, vertexBufferSize := list.VertexBuffer()
+		gl.BindBuffer(gl.ARRAY_BUFFER, renderer.buffers.vertexBuffer.handle)
+		gl.BufferData(gl.ARRAY_BUFFER, vertexBufferSize, vertexBuffer, gl.STREAM_DRAW)
+
+		indexBuffer, indexBufferSize := list.IndexBuffer()
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, renderer.buffers.indexBuffer.handle)
+		gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, indexBufferSize, indexBuffer, gl.STREAM_DRAW)
+	}
+}
+
+func BenchmarkUploadPerList(b *testing.B) {
+	runBenchmark(b, func(renderer *OpenGL3, lists []imgui.DrawList) {
+		for i := 0; i < b.N; i++ {
+			uploadPerList(renderer, lists)
+			gl.Finish()
+		}
+	})
+}
+
+func benchmarkUploadDrawLists(b *testing.B, strategy BufferStrategy) {
+	runBenchmark(b, func(renderer *OpenGL3, lists []imgui.DrawList) {
+		renderer.SetBufferStrategy(strategy)
+		for i := 0; i < b.N; i++ {
+			renderer.buffers.upload(lists, renderer.drawListVertices, drawListIndexBuffer, &renderer.stats.current)
+			gl.Finish()
+		}
+	})
+}
+
+func BenchmarkUploadOrphan(b *testing.B) {
+	benchmarkUploadDrawLists(b, BufferOrphan)
+}
+
+func BenchmarkUploadSubData(b *testing.B) {
+	benchmarkUploadDrawLists(b, BufferSubData)
+}
+
+func BenchmarkUploadMapRange(b *testing.B) {
+	benchmarkUploadDrawLists(b, BufferMapRange)
+}
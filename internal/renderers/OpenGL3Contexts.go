@@ -0,0 +1,68 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// SetContext tells the renderer which GL context is current. It must be called whenever the host
+// switches to another context that shares its objects with the one the renderer was created with,
+// before any other function of the renderer is called.
+//
+// Vertex array objects are not shared among GL contexts, so the renderer keeps one per context it
+// has rendered in. The context is identified by a key of the host's choosing, typically the handle of
+// the window (e.g. *glfw.Window). Applications with a single context do not need to call this function.
+//
+// The key must be comparable, and it must not be reused for another context while the renderer knows about it.
+// A nil key refers to the context the renderer was created with.
+func (renderer *OpenGL3) SetContext(key interface{}) {
+	renderer.currentContext = key
+}
+
+// ForgetContext removes all objects the renderer has created specifically for the identified context.
+// It must be called when the host destroys a context the renderer has rendered in.
+//
+// If the context is current, the objects are deleted. Otherwise they are assumed to have been
+// destroyed together with the context.
+func (renderer *OpenGL3) ForgetContext(key interface{}) {
+	vaoHandle, known := renderer.vertexArrays[key]
+	if !known {
+		return
+	}
+	if key == renderer.currentContext {
+		gl.DeleteVertexArrays(1, &vaoHandle)
+	}
+	delete(renderer.vertexArrays, key)
+}
+
+// bindVertexArray binds the VAO of the current context, creating and setting it up on first use.
+// The VAO keeps the vertex attribute layout and the binding of the vertex buffer.
+func (renderer *OpenGL3) bindVertexArray() {
+	vaoHandle, known := renderer.vertexArrays[renderer.currentContext]
+	if known {
+		gl.BindVertexArray(vaoHandle)
+		return
+	}
+
+	gl.GenVertexArrays(1, &vaoHandle)
+	gl.BindVertexArray(vaoHandle)
+	gl.BindBuffer(gl.ARRAY_BUFFER, renderer.buffers.vertexBuffer.handle)
+	gl.EnableVertexAttribArray(uint32(renderer.attribLocationPosition))
+	gl.EnableVertexAttribArray(uint32(renderer.attribLocationUV))
+	gl.EnableVertexAttribArray(uint32(renderer.attribLocationColor))
+	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
+	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationPosition), 2, gl.FLOAT, false, int32(vertexSize), uintptr(vertexOffsetPos))
+	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationUV), 2, gl.FLOAT, false, int32(vertexSize), uintptr(vertexOffsetUv))
+	gl.VertexAttribPointerWithOffset(uint32(renderer.attribLocationColor), 4, gl.UNSIGNED_BYTE, true, int32(vertexSize), uintptr(vertexOffsetCol))
+
+	renderer.vertexArrays[renderer.currentContext] = vaoHandle
+}
+
+// deleteVertexArrays deletes the VAO of the current context, and forgets those of all other contexts,
+// as these can not be deleted from here.
+func (renderer *OpenGL3) deleteVertexArrays() {
+	if vaoHandle, known := renderer.vertexArrays[renderer.currentContext]; known {
+		gl.DeleteVertexArrays(1, &vaoHandle)
+	}
+	renderer.vertexArrays = make(map[interface{}]uint32)
+}
@@ -0,0 +1,30 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+)
+
+// EnableDebug forwards the debug output of the context to a logger, see DebugOptions.
+func (renderer *OpenGL3) EnableDebug(options DebugOptions) error {
+	return renderer.debug.enable(openGL3Functions, options)
+}
+
+// openGL3DebugMessageCallback sets the debug callback of the bindings.
+func openGL3DebugMessageCallback(callback func(source, gltype, id, severity uint32, message string)) {
+	gl.DebugMessageCallback(func(source, gltype, id, severity uint32, _ int32, message string, _ unsafe.Pointer) {
+		callback(source, gltype, id, severity, message)
+	}, nil)
+}
+
+func hasOpenGL3Extension(name string) bool {
+	var count int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &count)
+	for index := int32(0); index < count; index++ {
+		if gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(index))) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package renderers
+
+import (
+	"fmt"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// OffscreenTarget is a framebuffer object the OpenGL3 renderer can draw into, instead of the default framebuffer.
+// The result is available as a texture, for example to be composited into a 3D scene, or to be read back.
+//
+// With multisampling, drawing happens into a multisampled renderbuffer, which is resolved into the texture
+// after each call to RenderOffscreen().
+//
+// A target that is created while the renderer is in sRGB mode stores its pixels in sRGB, and is drawn into
+// with blending in linear space, see OpenGL3.SetSRGB(). It keeps this mode for its lifetime.
+//
+// As with all OpenGL framebuffers, the first row of the texture is the bottom of the image.
+// To show the texture with imgui.ImageV(), swap the vertical texture coordinates: uv0 = (0, 1), uv1 = (1, 0).
+type OffscreenTarget struct {
+	renderer *OpenGL3
+
+	width   int32
+	height  int32
+	samples int32
+	srgb    bool
+
+	texture     uint32
+	framebuffer uint32
+
+	msColorbuffer uint32
+	msFramebuffer uint32
+}
+
+// NewOffscreenTarget creates a target of the given size, in pixels. A sample count of zero disables
+// multisampling. Sample counts above the maximum of the driver are reduced to that maximum.
+// The target is released with Dispose(), or when the renderer is disposed of.
+func (renderer *OpenGL3) NewOffscreenTarget(width, height int, samples int) (*OffscreenTarget, error) {
+	if width <= 0 || height <= 0 {
+		return nil, ErrInvalidTargetSize
+	}
+	target := &OffscreenTarget{
+		renderer: renderer,
+		width:    int32(width),
+		height:   int32(height),
+		srgb:     renderer.srgb,
+	}
+	internalFormat := uint32(gl.RGBA8)
+	if target.srgb {
+		internalFormat = gl.SRGB8_ALPHA8
+	}
+	if samples > 0 {
+		var maxSamples int32
+		gl.GetIntegerv(gl.MAX_SAMPLES, &maxSamples)
+		target.samples = int32(samples)
+		if target.samples > maxSamples {
+			target.samples = maxSamples
+		}
+	}
+
+	var lastTexture int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
+	var lastRenderbuffer int32
+	gl.GetIntegerv(gl.RENDERBUFFER_BINDING, &lastRenderbuffer)
+	var lastFramebuffer int32
+	gl.GetIntegerv(gl.FRAMEBUFFER_BINDING, &lastFramebuffer)
+	defer func() {
+		gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+		gl.BindRenderbuffer(gl.RENDERBUFFER, uint32(lastRenderbuffer))
+		gl.BindFramebuffer(gl.FRAMEBUFFER, uint32(lastFramebuffer))
+	}()
+
+	gl.GenTextures(1, &target.texture)
+	gl.BindTexture(gl.TEXTURE_2D, target.texture)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, int32(internalFormat), target.width, target.height, 0, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+
+	gl.GenFramebuffers(1, &target.framebuffer)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, target.framebuffer)
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, target.texture, 0)
+	err := checkFramebufferStatus()
+
+	if (err == nil) && (target.samples > 0) {
+		gl.GenRenderbuffers(1, &target.msColorbuffer)
+		gl.BindRenderbuffer(gl.RENDERBUFFER, target.msColorbuffer)
+		gl.RenderbufferStorageMultisample(gl.RENDERBUFFER, target.samples, internalFormat, target.width, target.height)
+
+		gl.GenFramebuffers(1, &target.msFramebuffer)
+		gl.BindFramebuffer(gl.FRAMEBUFFER, target.msFramebuffer)
+		gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.RENDERBUFFER, target.msColorbuffer)
+		err = checkFramebufferStatus()
+	}
+
+	if err != nil {
+		target.Dispose()
+		return nil, err
+	}
+
+	renderer.offscreenTargets[target] = struct{}{}
+	return target, nil
+}
+
+func checkFramebufferStatus() error {
+	status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER)
+	if status != gl.FRAMEBUFFER_COMPLETE {
+		return fmt.Errorf("%w: status 0x%X", ErrIncompleteFramebuffer, status)
+	}
+	return nil
+}
+
+// Dispose releases the GL objects of the target. The target must not be used afterwards.
+func (target *OffscreenTarget) Dispose() {
+	delete(target.renderer.offscreenTargets, target)
+
+	if target.msFramebuffer != 0 {
+		gl.DeleteFramebuffers(1, &target.msFramebuffer)
+		target.msFramebuffer = 0
+	}
+	if target.msColorbuffer != 0 {
+		gl.DeleteRenderbuffers(1, &target.msColorbuffer)
+		target.msColorbuffer = 0
+	}
+	if target.framebuffer != 0 {
+		gl.DeleteFramebuffers(1, &target.framebuffer)
+		target.framebuffer = 0
+	}
+	if target.texture != 0 {
+		gl.DeleteTextures(1, &target.texture)
+		target.texture = 0
+	}
+}
+
+// TextureID returns the texture that holds the result of RenderOffscreen().
+func (target *OffscreenTarget) TextureID() imgui.TextureID {
+	return imgui.TextureID(target.texture)
+}
+
+// Size returns the size of the target, in pixels.
+func (target *OffscreenTarget) Size() (width, height int) {
+	return int(target.width), int(target.height)
+}
+
+// Samples returns the number of samples per pixel. Zero means that multisampling is disabled.
+func (target *OffscreenTarget) Samples() int {
+	return int(target.samples)
+}
+
+// RenderOffscreen clears the target with the given color and draws the draw data into it.
+// The display area of the draw data, from its display position to its display position plus display size,
+// is scaled to the size of the target, which does not need to match the size or aspect ratio of the window.
+//
+// Clearing to transparent black results in a texture with premultiplied alpha.
+// The draws are not counted in Stats(), which only covers Render().
+func (renderer *OpenGL3) RenderOffscreen(target *OffscreenTarget, clearColor [4]float32, drawData imgui.DrawData) {
+	renderer.debug.pushGroup("imgui offscreen")
+	defer renderer.debug.popGroup()
+
+	var lastDrawFramebuffer int32
+	gl.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, &lastDrawFramebuffer)
+	var lastReadFramebuffer int32
+	gl.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, &lastReadFramebuffer)
+	var lastClearColor [4]float32
+	gl.GetFloatv(gl.COLOR_CLEAR_VALUE, &lastClearColor[0])
+	lastEnableScissorTest := gl.IsEnabled(gl.SCISSOR_TEST)
+
+	drawFramebuffer := target.framebuffer
+	if target.samples > 0 {
+		drawFramebuffer = target.msFramebuffer
+	}
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, drawFramebuffer)
+
+	gl.Disable(gl.SCISSOR_TEST)
+	if target.srgb {
+		// As with PreRender(), the clear color is written without encoding.
+		gl.Disable(gl.FRAMEBUFFER_SRGB)
+	}
+	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(lastClearColor[0], lastClearColor[1], lastClearColor[2], lastClearColor[3])
+	if lastEnableScissorTest {
+		gl.Enable(gl.SCISSOR_TEST)
+	}
+
+	renderer.render(newDrawViewportV(drawData, imgui.Vec2{X: float32(target.width), Y: float32(target.height)}), drawData,
+		renderTarget{srgb: target.srgb, premultiplied: true})
+
+	if target.samples > 0 {
+		gl.BindFramebuffer(gl.READ_FRAMEBUFFER, target.msFramebuffer)
+		gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, target.framebuffer)
+		gl.BlitFramebuffer(0, 0, target.width, target.height, 0, 0, target.width, target.height, gl.COLOR_BUFFER_BIT, gl.NEAREST)
+	}
+
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(lastDrawFramebuffer))
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(lastReadFramebuffer))
+}
+
+func (renderer *OpenGL3) disposeOffscreenTargets() {
+	for target := range renderer.offscreenTargets {
+		target.Dispose()
+	}
+	renderer.offscreenTargets = nil
+}
@@ -0,0 +1,384 @@
+package renderers
+
+import (
+	"image"
+	"math"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// SDFOptions configure the text that the OpenGL3 renderer draws from the signed distance field of the font atlas.
+// Distances are given in pixels of the font atlas, which are scaled along with the text.
+type SDFOptions struct {
+	// Spread is the largest distance from the edge of the glyphs that the field represents, see BuildDistanceField().
+	// Zero selects DefaultDistanceFieldSpread.
+	Spread int
+
+	// OutlineColor is the color of the outline, which is drawn outside of the glyphs. A transparent color disables the outline.
+	OutlineColor imgui.Vec4
+	// OutlineWidth is the width of the outline. It is limited to the spread.
+	OutlineWidth float32
+
+	// ShadowColor is the color of the shadow, which has the shape of the glyphs including their outline.
+	// A transparent color disables the shadow.
+	ShadowColor imgui.Vec4
+	// ShadowOffset is the offset of the shadow from the glyphs.
+	ShadowOffset imgui.Vec2
+}
+
+// openGL3SDF holds the resources for drawing text from the signed distance field of the font atlas.
+type openGL3SDF struct {
+	options SDFOptions
+
+	texture   uint32
+	atlas     *distanceFieldAtlas
+	atlasSize imgui.Vec2
+	program   shaderProgram
+
+	quads    []sdfVertices
+	glyphs   []image.Rectangle
+	vertices [][]byte
+
+	locationTex          int32
+	locationProjMtx      int32
+	locationLinearColor  int32
+	locationOutlineColor int32
+	locationOutlineWidth int32
+	locationShadowColor  int32
+	locationShadowOffset int32
+}
+
+// EnableSDF makes the renderer draw the text between the markers of DrawCallbacks.AddBeginSDF() and
+// DrawCallbacks.AddEndSDF() from a signed distance field of the font atlas. This text stays sharp at any scale,
+// such as with imgui.IO.SetFontGlobalScale(), without rebuilding the atlas. For best results, load the fonts
+// at the largest size they are shown at.
+//
+// It can be called again to change the options. The distance field is only rebuilt if the spread changes.
+func (renderer *OpenGL3) EnableSDF(options SDFOptions) error {
+	if options.Spread <= 0 {
+		options.Spread = DefaultDistanceFieldSpread
+	}
+	if options.OutlineWidth > float32(options.Spread) {
+		options.OutlineWidth = float32(options.Spread)
+	}
+
+	if renderer.sdf == nil {
+		sdf := &openGL3SDF{options: options}
+		err := renderer.createSDFProgram(sdf)
+		if err != nil {
+			sdf.delete()
+			return err
+		}
+		renderer.sdf = sdf
+	} else if renderer.sdf.options.Spread != options.Spread {
+		renderer.sdf.deleteTexture()
+	}
+	renderer.sdf.options = options
+	if renderer.sdf.texture == 0 {
+		renderer.createSDFTexture()
+	}
+	return nil
+}
+
+// DisableSDF releases the resources for SDF fonts. The text between the SDF markers is drawn from the regular font atlas again.
+func (renderer *OpenGL3) DisableSDF() {
+	if renderer.sdf != nil {
+		renderer.sdf.delete()
+		renderer.sdf = nil
+	}
+}
+
+// createSDFProgram creates the shader program for SDF text, which uses the vertex shader and vertex attribute
+// locations of the regular program, so that it can be used with the same vertex array objects.
+func (renderer *OpenGL3) createSDFProgram(sdf *openGL3SDF) error {
+	vertexShader, _, err := shaderSources(renderer.glslVersion)
+	if err != nil {
+		return err
+	}
+	fragmentShader, err := sdfFragmentShaderSource(renderer.glslVersion)
+	if err != nil {
+		return err
+	}
+
+	err = sdf.program.create(openGL3Functions, vertexShader, fragmentShader, "SDF fragment shader", []attribBinding{
+		{"Position", renderer.attribLocationPosition},
+		{"UV", renderer.attribLocationUV},
+		{"Color", renderer.attribLocationColor},
+	})
+	if err != nil {
+		return err
+	}
+	return sdf.program.lookupLocations(openGL3Functions, []shaderLocation{
+		{&sdf.locationTex, uniformLocation, "Texture"},
+		{&sdf.locationProjMtx, uniformLocation, "ProjMtx"},
+		{&sdf.locationLinearColor, uniformLocation, "LinearColor"},
+		{&sdf.locationOutlineColor, uniformLocation, "OutlineColor"},
+		{&sdf.locationOutlineWidth, uniformLocation, "OutlineWidth"},
+		{&sdf.locationShadowColor, uniformLocation, "ShadowColor"},
+		{&sdf.locationShadowOffset, uniformLocation, "ShadowOffset"},
+	})
+}
+
+// createSDFTexture creates the texture for the distance fields of the glyphs, which are built from the Alpha8
+// texture data of the font atlas, regardless of the format of the regular font texture. The fields of glyphs are
+// added to the texture when they are first drawn, see prepareSDF().
+func (renderer *OpenGL3) createSDFTexture() {
+	sdf := renderer.sdf
+	data := renderer.imguiIO.Fonts().TextureDataAlpha8()
+	coverage := &image.Alpha{
+		Pix:    unsafe.Slice((*uint8)(data.Pixels), data.Width*data.Height),
+		Stride: data.Width,
+		Rect:   image.Rect(0, 0, data.Width, data.Height),
+	}
+	sdf.atlas = newDistanceFieldAtlas(coverage, sdf.options.Spread)
+
+	var lastTexture int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
+	gl.GenTextures(1, &sdf.texture)
+	gl.BindTexture(gl.TEXTURE_2D, sdf.texture)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+}
+
+// uploadSDFTexture replaces the content of the texture with the distance fields, if glyphs were added since the last upload.
+func (renderer *OpenGL3) uploadSDFTexture() {
+	sdf := renderer.sdf
+	if !sdf.atlas.dirty {
+		return
+	}
+	size := sdf.atlas.field.Bounds().Size()
+	sdf.atlasSize = imgui.Vec2{X: float32(size.X), Y: float32(size.Y)}
+
+	var lastTexture int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
+	var lastUnpackRowLength, lastUnpackAlignment int32
+	gl.GetIntegerv(gl.UNPACK_ROW_LENGTH, &lastUnpackRowLength)
+	gl.GetIntegerv(gl.UNPACK_ALIGNMENT, &lastUnpackAlignment)
+	gl.BindTexture(gl.TEXTURE_2D, sdf.texture)
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RED, int32(size.X), int32(size.Y), 0, gl.RED, gl.UNSIGNED_BYTE, gl.Ptr(sdf.atlas.field.Pix))
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, lastUnpackRowLength)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, lastUnpackAlignment)
+	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+	sdf.atlas.dirty = false
+}
+
+// sdfVertices are vertices of a command list that are drawn from the distance field. They are either the four
+// vertices of the quad of a glyph, or a single solid vertex of other shapes, which is drawn from the solid block of the atlas.
+type sdfVertices struct {
+	list  int
+	first int
+	glyph image.Rectangle
+	solid bool
+}
+
+// prepareSDF adds the glyphs of the text between the SDF markers to the distance field atlas, and rewrites the
+// vertices of their quads: The quads are enlarged by the spread, so that outlines and shadows are not cut off
+// at the bounds of the glyphs, and their texture coordinates are moved into the distance field atlas.
+// The vertices of imgui are not modified, lists with rewritten vertices are uploaded from a copy, see drawListVertices().
+func (renderer *OpenGL3) prepareSDF(lists []imgui.DrawList) {
+	sdf := renderer.sdf
+	sdf.quads = sdf.quads[:0]
+	sdf.glyphs = sdf.glyphs[:0]
+	for len(sdf.vertices) < len(lists) {
+		sdf.vertices = append(sdf.vertices, nil)
+	}
+	for listIndex := range sdf.vertices {
+		sdf.vertices[listIndex] = sdf.vertices[listIndex][:0]
+	}
+
+	section := false
+	for listIndex, list := range lists {
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				continue
+			}
+			switch {
+			case cmd.TextureID() == beginSDFTextureID:
+				section = true
+			case cmd.TextureID() == endSDFTextureID:
+				section = false
+			case section && (uint32(cmd.TextureID()) == renderer.fontTexture):
+				sdf.collectVertices(listIndex, list, cmd)
+			}
+		}
+	}
+	if len(sdf.quads) == 0 {
+		return
+	}
+
+	sdf.atlas.prepare(sdf.glyphs)
+	renderer.uploadSDFTexture()
+	for _, quad := range sdf.quads {
+		sdf.rewriteVertices(quad, lists[quad.list])
+	}
+}
+
+// collectVertices finds the quads of the glyphs that the command draws. imgui writes the quads of glyphs as
+// four vertices, from the top left clockwise, and two triangles.
+func (sdf *openGL3SDF) collectVertices(listIndex int, list imgui.DrawList, cmd imgui.DrawCommand) {
+	indexBuffer, _ := list.IndexBuffer()
+	indexSize := imgui.IndexBufferLayout()
+	index := func(element int) int {
+		offset := uintptr((cmd.IndexOffset() + element) * indexSize)
+		const bytesPerUint32 = 4
+		if indexSize == bytesPerUint32 {
+			return cmd.VertexOffset() + int(*(*uint32)(unsafe.Add(indexBuffer, offset)))
+		}
+		return cmd.VertexOffset() + int(*(*uint16)(unsafe.Add(indexBuffer, offset)))
+	}
+
+	const indicesPerQuad = 6
+	count := cmd.ElementCount()
+	element := 0
+	for ; element+indicesPerQuad <= count; element += indicesPerQuad {
+		first := index(element)
+		isQuad := (index(element+1) == first+1) && (index(element+2) == first+2) &&
+			(index(element+3) == first) && (index(element+4) == first+2) && (index(element+5) == first+3)
+		if !isQuad {
+			for offset := 0; offset < indicesPerQuad; offset++ {
+				sdf.quads = append(sdf.quads, sdfVertices{list: listIndex, first: index(element + offset), solid: true})
+			}
+			continue
+		}
+		glyph, isGlyph := sdf.glyphRect(list, first)
+		if !isGlyph {
+			for vertex := first; vertex < first+4; vertex++ {
+				sdf.quads = append(sdf.quads, sdfVertices{list: listIndex, first: vertex, solid: true})
+			}
+			continue
+		}
+		sdf.quads = append(sdf.quads, sdfVertices{list: listIndex, first: first, glyph: glyph})
+		sdf.glyphs = append(sdf.glyphs, glyph)
+	}
+	for ; element < count; element++ {
+		sdf.quads = append(sdf.quads, sdfVertices{list: listIndex, first: index(element), solid: true})
+	}
+}
+
+// glyphRect returns the rectangle of the font atlas that the quad starting at the given vertex is textured with.
+// Quads that are textured with less than a pixel, such as the white pixel of the atlas, are no glyphs.
+func (sdf *openGL3SDF) glyphRect(list imgui.DrawList, first int) (image.Rectangle, bool) {
+	vertexBuffer, _ := list.VertexBuffer()
+	vertexSize, _, uvOffset, _ := imgui.VertexBufferLayout()
+	bounds := sdf.atlas.coverage.Bounds()
+	size := imgui.Vec2{X: float32(bounds.Dx()), Y: float32(bounds.Dy())}
+
+	// The texture coordinates of the top left and the bottom right vertex.
+	min := *(*imgui.Vec2)(unsafe.Add(vertexBuffer, first*vertexSize+uvOffset))
+	max := *(*imgui.Vec2)(unsafe.Add(vertexBuffer, (first+2)*vertexSize+uvOffset))
+	if ((max.X-min.X)*size.X < 0.5) || ((max.Y-min.Y)*size.Y < 0.5) {
+		return image.Rectangle{}, false
+	}
+	// Glyphs that imgui clips to the clip rectangle are textured with a part of their rectangle.
+	const epsilon = 1.0 / 64
+	glyph := image.Rect(
+		int(math.Floor(float64(min.X*size.X)+epsilon)), int(math.Floor(float64(min.Y*size.Y)+epsilon)),
+		int(math.Ceil(float64(max.X*size.X)-epsilon)), int(math.Ceil(float64(max.Y*size.Y)-epsilon))).Intersect(bounds)
+	return glyph, !glyph.Empty()
+}
+
+// rewriteVertices enlarges the quad of a glyph by the spread and moves its texture coordinates into the distance
+// field atlas, or moves the texture coordinates of a solid vertex into the solid block of the atlas.
+func (sdf *openGL3SDF) rewriteVertices(quad sdfVertices, list imgui.DrawList) {
+	vertices := sdf.drawListVertices(quad.list, list)
+	vertexSize, posOffset, uvOffset, _ := imgui.VertexBufferLayout()
+	position := func(vertex int) *imgui.Vec2 {
+		return (*imgui.Vec2)(unsafe.Pointer(&vertices[vertex*vertexSize+posOffset]))
+	}
+	uv := func(vertex int) *imgui.Vec2 {
+		return (*imgui.Vec2)(unsafe.Pointer(&vertices[vertex*vertexSize+uvOffset]))
+	}
+	fieldSize := sdf.atlas.field.Bounds().Size()
+	field := imgui.Vec2{X: float32(fieldSize.X), Y: float32(fieldSize.Y)}
+
+	area, placed := sdf.atlas.lookup(quad.glyph)
+	if quad.solid || !placed {
+		center := sdf.atlas.solid.Min.Add(sdf.atlas.solid.Max).Div(2)
+		*uv(quad.first) = imgui.Vec2{X: float32(center.X) / field.X, Y: float32(center.Y) / field.Y}
+		return
+	}
+
+	// The corners of the quad, in pixels of the screen and in pixels of the font atlas.
+	bounds := sdf.atlas.coverage.Bounds()
+	atlas := imgui.Vec2{X: float32(bounds.Dx()), Y: float32(bounds.Dy())}
+	minPos, maxPos := *position(quad.first), *position(quad.first + 2)
+	minUV, maxUV := *uv(quad.first), *uv(quad.first + 2)
+	spread := float32(sdf.options.Spread)
+	padding := imgui.Vec2{
+		X: spread * (maxPos.X - minPos.X) / ((maxUV.X - minUV.X) * atlas.X),
+		Y: spread * (maxPos.Y - minPos.Y) / ((maxUV.Y - minUV.Y) * atlas.Y),
+	}
+	// The glyph is at the spread from the top left of its area, and the quad starts at the spread before it.
+	fieldMin := imgui.Vec2{
+		X: (minUV.X*atlas.X - float32(quad.glyph.Min.X) + float32(area.Min.X)) / field.X,
+		Y: (minUV.Y*atlas.Y - float32(quad.glyph.Min.Y) + float32(area.Min.Y)) / field.Y,
+	}
+	fieldMax := imgui.Vec2{
+		X: (maxUV.X*atlas.X - float32(quad.glyph.Min.X) + float32(area.Min.X) + 2*spread) / field.X,
+		Y: (maxUV.Y*atlas.Y - float32(quad.glyph.Min.Y) + float32(area.Min.Y) + 2*spread) / field.Y,
+	}
+
+	for vertex := quad.first; vertex < quad.first+4; vertex++ {
+		pos, coords := position(vertex), uv(vertex)
+		if coords.X == minUV.X {
+			pos.X, coords.X = pos.X-padding.X, fieldMin.X
+		} else {
+			pos.X, coords.X = pos.X+padding.X, fieldMax.X
+		}
+		if coords.Y == minUV.Y {
+			pos.Y, coords.Y = pos.Y-padding.Y, fieldMin.Y
+		} else {
+			pos.Y, coords.Y = pos.Y+padding.Y, fieldMax.Y
+		}
+	}
+}
+
+// drawListVertices returns the rewritten vertices of the list, which are copied from the list when they are first requested in a frame.
+func (sdf *openGL3SDF) drawListVertices(listIndex int, list imgui.DrawList) []byte {
+	if len(sdf.vertices[listIndex]) == 0 {
+		vertexBuffer, vertexBufferSize := list.VertexBuffer()
+		sdf.vertices[listIndex] = append(sdf.vertices[listIndex], unsafe.Slice((*byte)(vertexBuffer), vertexBufferSize)...)
+	}
+	return sdf.vertices[listIndex]
+}
+
+// useSDFProgram switches to the program for SDF text, with the uniforms set from the options.
+// With srgb, the colors of the options are decoded to linear, as the vertex shader does with the vertex colors.
+func (renderer *OpenGL3) useSDFProgram(viewport drawViewport, srgb bool) {
+	sdf := renderer.sdf
+	spread := float32(sdf.options.Spread)
+	orthoProjection := viewport.orthoProjection()
+	outlineColor, shadowColor := sdf.options.OutlineColor, sdf.options.ShadowColor
+	if srgb {
+		outlineColor, shadowColor = linearColor(outlineColor), linearColor(shadowColor)
+	}
+
+	gl.UseProgram(sdf.program.handle)
+	gl.Uniform1i(sdf.locationTex, 0)
+	gl.UniformMatrix4fv(sdf.locationProjMtx, 1, false, &orthoProjection[0][0])
+	gl.Uniform1i(sdf.locationLinearColor, boolToInt32(srgb))
+	gl.Uniform4f(sdf.locationOutlineColor, outlineColor.X, outlineColor.Y, outlineColor.Z, outlineColor.W)
+	// The field changes by 0.5 over the spread, see BuildDistanceField().
+	gl.Uniform1f(sdf.locationOutlineWidth, sdf.options.OutlineWidth/(2*spread))
+	gl.Uniform4f(sdf.locationShadowColor, shadowColor.X, shadowColor.Y, shadowColor.Z, shadowColor.W)
+	gl.Uniform2f(sdf.locationShadowOffset, sdf.options.ShadowOffset.X/sdf.atlasSize.X, sdf.options.ShadowOffset.Y/sdf.atlasSize.Y)
+}
+
+func (sdf *openGL3SDF) deleteTexture() {
+	if sdf.texture != 0 {
+		gl.DeleteTextures(1, &sdf.texture)
+		sdf.texture = 0
+	}
+}
+
+func (sdf *openGL3SDF) delete() {
+	sdf.deleteTexture()
+	sdf.program.delete(openGL3Functions)
+}
@@ -0,0 +1,105 @@
+package renderers
+
+import (
+	"image"
+	"math"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// SetSRGB selects whether the renderer blends in linear space. This requires a default framebuffer that encodes
+// colors to sRGB, which the platforms request with platforms.Options.SRGB.
+//
+// In sRGB mode, Render() enables FRAMEBUFFER_SRGB, the colors of the vertices, which include the colors of the style,
+// are decoded from sRGB to linear in the vertex shader, and color textures are stored as SRGB8_ALPHA8, so that
+// sampling decodes them as well. Existing textures keep their IDs. The Alpha8 font texture holds coverage,
+// which stays linear. Colors given to PreRender() are written as they are.
+//
+// Offscreen targets keep the mode they were created in.
+func (renderer *OpenGL3) SetSRGB(enabled bool) error {
+	if enabled == renderer.srgb {
+		return nil
+	}
+	if enabled && !defaultFramebufferSRGB() {
+		return ErrSRGBUnsupported
+	}
+	renderer.srgb = enabled
+
+	format := renderer.colorTextureFormat()
+	for texture, size := range renderer.textures.sizes {
+		respecifyTexture(texture, size, format)
+	}
+	if (renderer.fontTexture != 0) && (renderer.fontImage.format == FontAtlasRGBA32) {
+		respecifyTexture(renderer.fontTexture, image.Point{X: renderer.fontImage.width, Y: renderer.fontImage.height}, format)
+	}
+	return nil
+}
+
+// SRGB returns true if the renderer is in sRGB mode, see SetSRGB().
+func (renderer *OpenGL3) SRGB() bool {
+	return renderer.srgb
+}
+
+// colorTextureFormat returns the internal format of textures that hold colors.
+func (renderer *OpenGL3) colorTextureFormat() int32 {
+	if renderer.srgb {
+		return gl.SRGB8_ALPHA8
+	}
+	return gl.RGBA
+}
+
+// defaultFramebufferSRGB returns true if the back buffer of the default framebuffer encodes colors to sRGB.
+func defaultFramebufferSRGB() bool {
+	var lastFramebuffer int32
+	gl.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, &lastFramebuffer)
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, 0)
+	var encoding int32
+	gl.GetFramebufferAttachmentParameteriv(gl.DRAW_FRAMEBUFFER, gl.BACK_LEFT, gl.FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING, &encoding)
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(lastFramebuffer))
+	return encoding == gl.SRGB
+}
+
+// respecifyTexture changes the internal format of an RGBA texture, keeping its pixels and parameters.
+// The bytes are copied as they are, so that the pixels are interpreted as sRGB, or no longer so, from then on.
+// The binding of the 2D texture and the pack and unpack state are left unchanged.
+func respecifyTexture(texture uint32, size image.Point, internalFormat int32) {
+	const bytesPerPixel = 4
+	pixels := make([]uint8, size.X*size.Y*bytesPerPixel)
+	if len(pixels) == 0 {
+		return
+	}
+
+	var lastTexture int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &lastTexture)
+	var lastPackRowLength, lastPackAlignment, lastUnpackRowLength, lastUnpackAlignment int32
+	gl.GetIntegerv(gl.PACK_ROW_LENGTH, &lastPackRowLength)
+	gl.GetIntegerv(gl.PACK_ALIGNMENT, &lastPackAlignment)
+	gl.GetIntegerv(gl.UNPACK_ROW_LENGTH, &lastUnpackRowLength)
+	gl.GetIntegerv(gl.UNPACK_ALIGNMENT, &lastUnpackAlignment)
+
+	gl.BindTexture(gl.TEXTURE_2D, texture)
+	gl.PixelStorei(gl.PACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 4)
+	gl.GetTexImage(gl.TEXTURE_2D, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 4)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, internalFormat, int32(size.X), int32(size.Y), 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+
+	gl.PixelStorei(gl.PACK_ROW_LENGTH, lastPackRowLength)
+	gl.PixelStorei(gl.PACK_ALIGNMENT, lastPackAlignment)
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, lastUnpackRowLength)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, lastUnpackAlignment)
+	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
+}
+
+// linearColor decodes the color components of an sRGB color, as the vertex shader does in sRGB mode.
+func linearColor(color imgui.Vec4) imgui.Vec4 {
+	decode := func(value float32) float32 {
+		if value <= 0.04045 {
+			return value / 12.92
+		}
+		return float32(math.Pow((float64(value)+0.055)/1.055, 2.4))
+	}
+	return imgui.Vec4{X: decode(color.X), Y: decode(color.Y), Z: decode(color.Z), W: color.W}
+}
@@ -0,0 +1,54 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+)
+
+// OpenGL3State is a snapshot of the OpenGL state that the OpenGL3 renderer changes.
+// Only the groups it was captured with are restored.
+type OpenGL3State struct {
+	glState
+
+	sampler     int32
+	vertexArray int32
+	enableSRGB  bool
+	polygonMode [2]int32
+}
+
+// CaptureOpenGL3State reads the selected groups of state from the current context.
+// Capturing StateTextures leaves the first texture unit active.
+func CaptureOpenGL3State(groups StateGroups) OpenGL3State {
+	state := OpenGL3State{glState: captureGLState(openGL3Functions, groups)}
+
+	if groups.Has(StateTextures) {
+		gl.GetIntegerv(gl.SAMPLER_BINDING, &state.sampler)
+	}
+	if groups.Has(StateBuffers) {
+		gl.GetIntegerv(gl.VERTEX_ARRAY_BINDING, &state.vertexArray)
+	}
+	if groups.Has(StateCapabilities) {
+		state.enableSRGB = gl.IsEnabled(gl.FRAMEBUFFER_SRGB)
+	}
+	if groups.Has(StateViewport) {
+		gl.GetIntegerv(gl.POLYGON_MODE, &state.polygonMode[0])
+	}
+
+	return state
+}
+
+// Restore writes the captured state back to the current context.
+func (state OpenGL3State) Restore() {
+	if state.groups.Has(StateBuffers) {
+		gl.BindVertexArray(uint32(state.vertexArray))
+	}
+	state.restore(openGL3Functions)
+	if state.groups.Has(StateTextures) {
+		gl.BindSampler(0, uint32(state.sampler))
+	}
+	if state.groups.Has(StateCapabilities) {
+		setCapability(openGL3Functions, gl.FRAMEBUFFER_SRGB, state.enableSRGB)
+	}
+	if state.groups.Has(StateViewport) {
+		gl.PolygonMode(gl.FRONT_AND_BACK, uint32(state.polygonMode[0]))
+	}
+}
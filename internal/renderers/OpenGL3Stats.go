@@ -0,0 +1,55 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.2-core/gl"
+)
+
+// Stats returns the numbers of the previous frame, see FrameStats. The GPU time requires OpenGL 3.3,
+// or the ARB_timer_query extension.
+func (renderer *OpenGL3) Stats() FrameStats {
+	stats := renderer.stats.previous
+	renderer.timer.addTo(&stats)
+	return stats
+}
+
+// hasOpenGL3TimerQueries returns true if the context supports GL_TIME_ELAPSED queries.
+func hasOpenGL3TimerQueries() bool {
+	var major, minor int32
+	gl.GetIntegerv(gl.MAJOR_VERSION, &major)
+	gl.GetIntegerv(gl.MINOR_VERSION, &minor)
+	if (major > 3) || ((major == 3) && (minor >= 3)) {
+		return true
+	}
+	return hasOpenGL3Extension("GL_ARB_timer_query")
+}
+
+// openGL3TimerQueries implements timerQueryAPI with OpenGL 3.3, or ARB_timer_query which uses the same names.
+type openGL3TimerQueries struct{}
+
+func (openGL3TimerQueries) genQueries(queries []uint32) {
+	gl.GenQueries(int32(len(queries)), &queries[0])
+}
+
+func (openGL3TimerQueries) deleteQueries(queries []uint32) {
+	gl.DeleteQueries(int32(len(queries)), &queries[0])
+}
+
+func (openGL3TimerQueries) begin(query uint32) {
+	gl.BeginQuery(gl.TIME_ELAPSED, query)
+}
+
+func (openGL3TimerQueries) end() {
+	gl.EndQuery(gl.TIME_ELAPSED)
+}
+
+func (openGL3TimerQueries) available(query uint32) bool {
+	var available uint32
+	gl.GetQueryObjectuiv(query, gl.QUERY_RESULT_AVAILABLE, &available)
+	return available != 0
+}
+
+func (openGL3TimerQueries) result(query uint32) uint64 {
+	var elapsed uint64
+	gl.GetQueryObjectui64v(query, gl.QUERY_RESULT, &elapsed)
+	return elapsed
+}
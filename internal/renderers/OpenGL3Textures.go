@@ -0,0 +1,30 @@
+package renderers
+
+import (
+	"image"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// CreateTexture uploads the image into a new texture. The returned ID can be used with imgui.Image() and the draw list functions.
+// The texture is released with FreeTexture(), or when the renderer is disposed of.
+func (renderer *OpenGL3) CreateTexture(img image.Image, options TextureOptions) imgui.TextureID {
+	return imgui.TextureID(renderer.textures.create(img, options, renderer.colorTextureFormat()))
+}
+
+// UpdateTexture replaces a region of a texture with the pixels of the image.
+// The region is given by the bounds of the image: Use the SubImage() function of the standard image types
+// to update only part of a texture.
+func (renderer *OpenGL3) UpdateTexture(id imgui.TextureID, img image.Image) error {
+	return renderer.textures.update(uint32(id), img)
+}
+
+// SetTextureOptions changes the sampling of a texture.
+func (renderer *OpenGL3) SetTextureOptions(id imgui.TextureID, options TextureOptions) error {
+	return renderer.textures.setOptions(uint32(id), options)
+}
+
+// FreeTexture releases a texture that was created with CreateTexture(). Unknown IDs are ignored.
+func (renderer *OpenGL3) FreeTexture(id imgui.TextureID) {
+	renderer.textures.free(uint32(id))
+}
@@ -0,0 +1,464 @@
+package renderers
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.0/gles2"
+	gles3 "github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.0/gles2"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// OpenGLES implements a renderer based on OpenGL ES 2.0 or 3.0, using github.com/go-gl/glow (v2.0 and v3.0, gles2)
+// generated bindings.
+//
+// The renderer draws through the bindings of OpenGL ES 2.0. On OpenGL ES 3.0 contexts, it also loads the bindings
+// of OpenGL ES 3.0, in order to keep the vertex array object of the host untouched. Drivers that do not export all
+// entry points of OpenGL ES 3.0 are treated as OpenGL ES 2.0. On Linux, build with the "egl" tag if the context
+// is created through EGL.
+//
+// All command lists of a frame are uploaded into one vertex buffer and one index buffer. OpenGL ES before 3.2 can
+// not offset the vertex indices of a draw call, so the vertex attributes are pointed at the vertices of each list.
+// imgui creates 32-bit indices for command lists with more vertices than 16-bit indices can address, which
+// OpenGL ES 2.0 draws with the OES_element_index_uint extension. Without it, the indices are converted to 16 bits,
+// relative to the first vertex of a segment of the command. Commands whose vertices are further apart are drawn in
+// several segments. Triangles whose own vertices are further apart can not be drawn, and are reported through
+// the debug output, see EnableDebug().
+type OpenGLES struct {
+	imguiIO imgui.IO
+
+	es3                    bool
+	vertexArrays           bool
+	uintIndices            bool
+	narrowedLists          []narrowedList
+	glslVersion            string
+	fontTexture            uint32
+	fontFormat             FontAtlasFormat
+	fontImage              fontAtlasImage
+	program                shaderProgram
+	attribLocationTex      int32
+	attribLocationProjMtx  int32
+	attribLocationAlpha    int32
+	attribLocationPosition int32
+	attribLocationUV       int32
+	attribLocationColor    int32
+	buffers                drawListBuffers
+	pointedVertex          int
+	stateGroups            StateGroups
+	debug                  debugOutput
+	callbacks              *DrawCallbacks
+	transparent            bool
+
+	viewport drawViewport
+}
+
+var openGLESFunctions = &glFunctions{
+	GetIntegerv: gles2.GetIntegerv,
+	IsEnabled:   gles2.IsEnabled,
+	Enable:      gles2.Enable,
+	Disable:     gles2.Disable,
+	BindBuffer:  gles2.BindBuffer,
+
+	GenBuffers:    gles2.GenBuffers,
+	DeleteBuffers: gles2.DeleteBuffers,
+	BufferData:    gles2.BufferData,
+	BufferSubData: gles2.BufferSubData,
+
+	ActiveTexture:         gles2.ActiveTexture,
+	BlendEquationSeparate: gles2.BlendEquationSeparate,
+	BlendFuncSeparate:     gles2.BlendFuncSeparate,
+	Viewport:              gles2.Viewport,
+	Scissor:               gles2.Scissor,
+
+	Str:                gles2.Str,
+	Strs:               gles2.Strs,
+	CreateProgram:      gles2.CreateProgram,
+	DeleteProgram:      gles2.DeleteProgram,
+	CreateShader:       gles2.CreateShader,
+	DeleteShader:       gles2.DeleteShader,
+	AttachShader:       gles2.AttachShader,
+	DetachShader:       gles2.DetachShader,
+	ShaderSource:       gles2.ShaderSource,
+	CompileShader:      gles2.CompileShader,
+	GetShaderiv:        gles2.GetShaderiv,
+	GetShaderInfoLog:   gles2.GetShaderInfoLog,
+	BindAttribLocation: gles2.BindAttribLocation,
+	LinkProgram:        gles2.LinkProgram,
+	GetProgramiv:       gles2.GetProgramiv,
+	GetProgramInfoLog:  gles2.GetProgramInfoLog,
+	GetUniformLocation: gles2.GetUniformLocation,
+	GetAttribLocation:  gles2.GetAttribLocation,
+	UseProgram:         gles2.UseProgram,
+
+	GenTextures:    gles2.GenTextures,
+	DeleteTextures: gles2.DeleteTextures,
+	BindTexture:    gles2.BindTexture,
+	TexParameteri:  gles2.TexParameteri,
+	PixelStorei:    gles2.PixelStorei,
+	TexImage2D:     gles2.TexImage2D,
+	TexSubImage2D:  gles2.TexSubImage2D,
+	ReadPixels:     gles2.ReadPixels,
+
+	HasExtension:         hasOpenGLESExtension,
+	DebugMessageCallback: openGLESDebugMessageCallback,
+	PushDebugGroup:       gles2.PushDebugGroupKHR,
+	PopDebugGroup:        gles2.PopDebugGroupKHR,
+}
+
+// NewOpenGLES attempts to initialize a renderer, with shaders matching the GLSL ES version of the context.
+// An OpenGL ES context has to be established before calling this function.
+func NewOpenGLES(io imgui.IO) (*OpenGLES, error) {
+	err := gles2.Init()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize OpenGL ES: %w", err)
+	}
+
+	number, _, err := parseGLSLVersion(gles2.GoStr(gles2.GetString(gles2.SHADING_LANGUAGE_VERSION)))
+	if err != nil {
+		return nil, err
+	}
+
+	es3 := number >= 300
+	renderer := &OpenGLES{
+		imguiIO:      io,
+		es3:          es3,
+		vertexArrays: es3 && (gles3.Init() == nil),
+		uintIndices:  es3 || hasOpenGLESExtension("GL_OES_element_index_uint"),
+		glslVersion:  glslVersionFor(number, true),
+		stateGroups:  StateAll,
+		callbacks:    newDrawCallbacks(),
+	}
+	err = renderer.createDeviceObjects()
+	if err != nil {
+		renderer.invalidateDeviceObjects()
+		return nil, err
+	}
+
+	return renderer, nil
+}
+
+// Dispose cleans up the resources.
+func (renderer *OpenGLES) Dispose() {
+	renderer.invalidateDeviceObjects()
+}
+
+// NewFrame prepares the renderer for a new frame and must be called before imgui.NewFrame().
+// It clears the draw callbacks of the previous frame, and recreates the font texture if the font atlas was rebuilt.
+func (renderer *OpenGLES) NewFrame() {
+	renderer.callbacks.clear()
+
+	if fontAtlasRebuilt(renderer.imguiIO.Fonts(), renderer.fontFormat, renderer.fontTexture, renderer.fontImage) {
+		renderer.RebuildFontsTexture()
+	}
+}
+
+// RebuildFontsTexture replaces the font texture with one created from the current state of the font atlas.
+// The new texture is stored as the texture ID of the atlas. Any previous texture ID becomes invalid.
+// It must be called after fonts have been added to or removed from the atlas, and not between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGLES) RebuildFontsTexture() {
+	renderer.destroyFontsTexture()
+	renderer.createFontsTexture()
+}
+
+// GLSLVersion returns the #version directive the shaders of the renderer were compiled with.
+func (renderer *OpenGLES) GLSLVersion() string {
+	return renderer.glslVersion
+}
+
+// SetStateBackup selects the groups of OpenGL ES state that Render() saves before rendering and restores afterwards.
+// The default is StateAll. Hosts that set up all of their state themselves can pass StateNone.
+func (renderer *OpenGLES) SetStateBackup(groups StateGroups) {
+	renderer.stateGroups = groups
+}
+
+// SetFontAtlasFormat selects the pixel format of the font texture, and recreates the texture if the format changes.
+// The default is FontAtlasAlpha8. It must not be called between imgui.NewFrame() and imgui.Render().
+func (renderer *OpenGLES) SetFontAtlasFormat(format FontAtlasFormat) {
+	if format == renderer.fontFormat {
+		return
+	}
+	renderer.fontFormat = format
+	renderer.RebuildFontsTexture()
+}
+
+// DrawCallbacks returns the registry of the functions that are called while rendering the current frame.
+func (renderer *OpenGLES) DrawCallbacks() *DrawCallbacks {
+	return renderer.callbacks
+}
+
+// SetTransparent selects whether the framebuffer is the one of a transparent window, see platforms.Options.
+// Compositors expect premultiplied alpha from such windows, so the clear color is then premultiplied.
+// The default is false, for opaque windows.
+func (renderer *OpenGLES) SetTransparent(transparent bool) {
+	renderer.transparent = transparent
+}
+
+// PreRender clears the framebuffer. The clear color has straight alpha. It is written as given,
+// or with premultiplied alpha if the framebuffer is transparent, see SetTransparent().
+func (renderer *OpenGLES) PreRender(clearColor [4]float32) {
+	if renderer.transparent {
+		clearColor = [4]float32{clearColor[0] * clearColor[3], clearColor[1] * clearColor[3], clearColor[2] * clearColor[3], clearColor[3]}
+	}
+	gles2.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
+	gles2.Clear(gles2.COLOR_BUFFER_BIT)
+}
+
+// Render translates the ImGui draw data to OpenGL ES commands.
+// The display position, display size, and framebuffer scale of the draw data determine the area that is rendered.
+func (renderer *OpenGLES) Render(drawData imgui.DrawData) {
+	viewport := newDrawViewport(drawData)
+	renderer.viewport = viewport
+
+	// Avoid rendering when minimized
+	if viewport.empty() {
+		return
+	}
+
+	renderer.debug.pushGroup("imgui")
+
+	// Backup GL state
+	lastState := CaptureOpenGLESState(renderer.stateGroups, renderer.vertexArrays)
+
+	renderer.setupRenderState(viewport)
+	alphaTexture := false
+
+	indexSize := imgui.IndexBufferLayout()
+	drawType := uint32(gles2.UNSIGNED_SHORT)
+	const bytesPerUint16 = 2
+	const bytesPerUint32 = 4
+	narrow := false
+	if indexSize == bytesPerUint32 {
+		if renderer.uintIndices {
+			drawType = gles2.UNSIGNED_INT
+		} else {
+			narrow = true
+			indexSize = bytesPerUint16
+		}
+	}
+
+	// Draw
+	lists := drawData.CommandLists()
+	indices := drawListIndexBuffer
+	if narrow {
+		renderer.narrowLists(lists)
+		indices = renderer.narrowedIndexBuffer
+	}
+	renderer.buffers.upload(lists, drawListVertexBuffer, indices, nil)
+	for listIndex, list := range lists {
+		offsets := renderer.buffers.listOffsets[listIndex]
+		for commandIndex, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				cmd.CallUserCallback(list)
+			} else if cmd.TextureID() == resetRenderStateTextureID {
+				renderer.setupRenderState(viewport)
+				alphaTexture = false
+			} else if isMarkerTextureID(cmd.TextureID()) {
+				// SDF fonts are not supported, the text is drawn from the regular font atlas.
+			} else {
+				x, y, width, height, visible := viewport.scissor(cmd.ClipRect())
+				if !visible {
+					continue
+				}
+				gles2.Scissor(x, y, width, height)
+				if callback, isCallback := renderer.callbacks.lookup(cmd.TextureID()); isCallback {
+					callback.call(viewport, cmd.ClipRect(), [4]int32{x, y, width, height})
+					continue
+				}
+				if isAlpha := renderer.isAlphaTexture(cmd.TextureID()); isAlpha != alphaTexture {
+					alphaTexture = isAlpha
+					gles2.Uniform1i(renderer.attribLocationAlpha, boolToInt32(alphaTexture))
+				}
+				gles2.BindTexture(gles2.TEXTURE_2D, uint32(cmd.TextureID()))
+				if !narrow {
+					renderer.drawElements(offsets.baseVertex, offsets.indexOffset+cmd.IndexOffset()*indexSize, cmd.ElementCount(), drawType)
+					continue
+				}
+				for _, segment := range renderer.narrowedLists[listIndex].segments[commandIndex] {
+					renderer.drawElements(offsets.baseVertex+segment.baseVertex, offsets.indexOffset+segment.first*indexSize,
+						segment.count, drawType)
+				}
+			}
+		}
+	}
+
+	gles2.DisableVertexAttribArray(uint32(renderer.attribLocationPosition))
+	gles2.DisableVertexAttribArray(uint32(renderer.attribLocationUV))
+	gles2.DisableVertexAttribArray(uint32(renderer.attribLocationColor))
+
+	// Restore modified GL state
+	lastState.Restore()
+	renderer.debug.popGroup()
+}
+
+// drawElements draws the indices at the given offset in the index buffer, relative to the given vertex.
+func (renderer *OpenGLES) drawElements(baseVertex int, indexOffset int, count int, drawType uint32) {
+	if baseVertex != renderer.pointedVertex {
+		renderer.pointVertexAttributes(baseVertex)
+	}
+	gles2.DrawElementsWithOffset(gles2.TRIANGLES, int32(count), drawType, uintptr(indexOffset))
+}
+
+// pointVertexAttributes points the vertex attributes at the vertices from the given one on, in the vertex buffer.
+func (renderer *OpenGLES) pointVertexAttributes(baseVertex int) {
+	vertexSize, vertexOffsetPos, vertexOffsetUv, vertexOffsetCol := imgui.VertexBufferLayout()
+	offset := baseVertex * vertexSize
+	gles2.VertexAttribPointerWithOffset(uint32(renderer.attribLocationPosition), 2, gles2.FLOAT, false, int32(vertexSize), uintptr(offset+vertexOffsetPos))
+	gles2.VertexAttribPointerWithOffset(uint32(renderer.attribLocationUV), 2, gles2.FLOAT, false, int32(vertexSize), uintptr(offset+vertexOffsetUv))
+	gles2.VertexAttribPointerWithOffset(uint32(renderer.attribLocationColor), 4, gles2.UNSIGNED_BYTE, true, int32(vertexSize), uintptr(offset+vertexOffsetCol))
+	renderer.pointedVertex = baseVertex
+}
+
+// narrowLists converts the 32-bit indices of the command lists to 16-bit ones, for contexts that can not draw
+// with 32-bit indices, see narrowedList.
+func (renderer *OpenGLES) narrowLists(lists []imgui.DrawList) {
+	if cap(renderer.narrowedLists) < len(lists) {
+		renderer.narrowedLists = append(renderer.narrowedLists[:cap(renderer.narrowedLists)],
+			make([]narrowedList, len(lists)-cap(renderer.narrowedLists))...)
+	}
+	renderer.narrowedLists = renderer.narrowedLists[:len(lists)]
+	skipped := 0
+	for listIndex, list := range lists {
+		skipped += renderer.narrowedLists[listIndex].narrow(list)
+	}
+	if skipped > 0 {
+		renderer.debug.warn(fmt.Sprintf("skipped %v triangles whose vertices are too far apart for 16-bit indices", skipped))
+	}
+}
+
+// narrowedIndexBuffer returns the 16-bit indices of the list, see narrowLists().
+func (renderer *OpenGLES) narrowedIndexBuffer(listIndex int, _ imgui.DrawList) (unsafe.Pointer, int) {
+	const bytesPerUint16 = 2
+	indices := renderer.narrowedLists[listIndex].indices
+	if len(indices) == 0 {
+		return nil, 0
+	}
+	return unsafe.Pointer(&indices[0]), len(indices) * bytesPerUint16
+}
+
+// setupRenderState sets the state for rendering the draw data. It is called at the start of rendering,
+// and again for each command added by DrawCallbacks.AddResetRenderState().
+func (renderer *OpenGLES) setupRenderState(viewport drawViewport) {
+	// Setup render state: alpha-blending enabled, no face culling, no depth testing, scissor enabled
+	gles2.ActiveTexture(gles2.TEXTURE0)
+	gles2.Enable(gles2.BLEND)
+	gles2.BlendEquation(gles2.FUNC_ADD)
+	gles2.BlendFuncSeparate(gles2.SRC_ALPHA, gles2.ONE_MINUS_SRC_ALPHA, gles2.ONE, gles2.ONE_MINUS_SRC_ALPHA)
+	gles2.Disable(gles2.CULL_FACE)
+	gles2.Disable(gles2.DEPTH_TEST)
+	gles2.Enable(gles2.SCISSOR_TEST)
+
+	// Setup viewport, orthographic projection matrix
+	gles2.Viewport(0, 0, int32(viewport.framebufferSize.X), int32(viewport.framebufferSize.Y))
+	orthoProjection := viewport.orthoProjection()
+	gles2.UseProgram(renderer.program.handle)
+	gles2.Uniform1i(renderer.attribLocationTex, 0)
+	gles2.UniformMatrix4fv(renderer.attribLocationProjMtx, 1, false, &orthoProjection[0][0])
+	gles2.Uniform1i(renderer.attribLocationAlpha, 0)
+
+	// Without vertex array objects in OpenGL ES 2.0, the vertex attributes are set up each frame.
+	// OpenGL ES 3.0 has a default vertex array object, which is used so that those of the host remain untouched.
+	if renderer.vertexArrays {
+		gles3.BindVertexArray(0)
+	}
+	gles2.BindBuffer(gles2.ARRAY_BUFFER, renderer.buffers.vertexBuffer.handle)
+	gles2.BindBuffer(gles2.ELEMENT_ARRAY_BUFFER, renderer.buffers.indexBuffer.handle)
+	gles2.EnableVertexAttribArray(uint32(renderer.attribLocationPosition))
+	gles2.EnableVertexAttribArray(uint32(renderer.attribLocationUV))
+	gles2.EnableVertexAttribArray(uint32(renderer.attribLocationColor))
+	renderer.pointVertexAttributes(0)
+}
+
+// Capture reads back the frame that was last rendered. It must be called after Render() and before the
+// display buffer is swapped, as the content of the buffer is undefined afterwards.
+// The region is given in display coordinates, the same as imgui uses for window positions. An empty region captures
+// the whole frame. The returned image has the size of the captured region in framebuffer pixels.
+//
+// The alpha channel is the one of the framebuffer, with premultiplied alpha, as image.RGBA expects. It is opaque unless
+// the clear color, or what was drawn, is transparent, and the framebuffer has an alpha channel.
+func (renderer *OpenGLES) Capture(region image.Rectangle) (*image.RGBA, error) {
+	// OpenGL ES 2.0 has no pixel pack state besides the alignment.
+	return captureFramebuffer(openGLESFunctions, region, renderer.viewport, !renderer.es3)
+}
+
+func (renderer *OpenGLES) createDeviceObjects() error {
+	// Backup GL state
+	var lastTexture int32
+	var lastArrayBuffer int32
+	gles2.GetIntegerv(gles2.TEXTURE_BINDING_2D, &lastTexture)
+	gles2.GetIntegerv(gles2.ARRAY_BUFFER_BINDING, &lastArrayBuffer)
+	defer func() {
+		// Restore modified GL state
+		gles2.BindTexture(gles2.TEXTURE_2D, uint32(lastTexture))
+		gles2.BindBuffer(gles2.ARRAY_BUFFER, uint32(lastArrayBuffer))
+	}()
+
+	vertexShader, fragmentShader, err := shaderSources(renderer.glslVersion)
+	if err != nil {
+		return err
+	}
+
+	err = renderer.program.create(openGLESFunctions, vertexShader, fragmentShader, "fragment shader", nil)
+	if err != nil {
+		return err
+	}
+	err = renderer.program.lookupLocations(openGLESFunctions, []shaderLocation{
+		{&renderer.attribLocationTex, uniformLocation, "Texture"},
+		{&renderer.attribLocationProjMtx, uniformLocation, "ProjMtx"},
+		{&renderer.attribLocationAlpha, uniformLocation, "AlphaTexture"},
+		{&renderer.attribLocationPosition, attribLocation, "Position"},
+		{&renderer.attribLocationUV, attribLocation, "UV"},
+		{&renderer.attribLocationColor, attribLocation, "Color"},
+	})
+	if err != nil {
+		return err
+	}
+
+	renderer.buffers.create(openGLESFunctions)
+
+	renderer.createFontsTexture()
+
+	return nil
+}
+
+func (renderer *OpenGLES) createFontsTexture() {
+	// Build texture atlas
+	io := imgui.CurrentIO()
+	image := currentFontAtlasImage(io.Fonts(), renderer.fontFormat)
+
+	// Upload texture to graphics system
+	// OpenGL ES 2.0 has no single-channel RED format. The shaders read the coverage of the Alpha8 atlas
+	// from the red channel, which a LUMINANCE texture provides in both versions.
+	format := int32(gles2.LUMINANCE)
+	if image.format == FontAtlasRGBA32 {
+		format = gles2.RGBA
+	}
+	renderer.fontTexture = createFontTexture(openGLESFunctions, image, format, uint32(format))
+	renderer.fontImage = image
+
+	// Store our identifier
+	io.Fonts().SetTextureID(imgui.TextureID(renderer.fontTexture))
+}
+
+// isAlphaTexture returns true if the texture is the font atlas in the Alpha8 format, which the shader reads as coverage.
+// All other textures, including the font atlas in the RGBA32 format, are multiplied with the vertex color.
+func (renderer *OpenGLES) isAlphaTexture(id imgui.TextureID) bool {
+	return (renderer.fontFormat == FontAtlasAlpha8) && (uint32(id) == renderer.fontTexture)
+}
+
+func (renderer *OpenGLES) invalidateDeviceObjects() {
+	renderer.buffers.delete()
+
+	renderer.program.delete(openGLESFunctions)
+
+	renderer.destroyFontsTexture()
+}
+
+func (renderer *OpenGLES) destroyFontsTexture() {
+	if renderer.fontTexture != 0 {
+		gles2.DeleteTextures(1, &renderer.fontTexture)
+		imgui.CurrentIO().Fonts().SetTextureID(0)
+		renderer.fontTexture = 0
+		renderer.fontImage = fontAtlasImage{}
+	}
+}
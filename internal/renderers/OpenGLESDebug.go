@@ -0,0 +1,23 @@
+package renderers
+
+import (
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.0/gles2"
+)
+
+// EnableDebug forwards the debug output of the context to a logger, see DebugOptions.
+func (renderer *OpenGLES) EnableDebug(options DebugOptions) error {
+	return renderer.debug.enable(openGLESFunctions, options)
+}
+
+// openGLESDebugMessageCallback sets the debug callback of the bindings.
+func openGLESDebugMessageCallback(callback func(source, gltype, id, severity uint32, message string)) {
+	gles2.DebugMessageCallbackKHR(func(source, gltype, id, severity uint32, _ int32, message string, _ unsafe.Pointer) {
+		callback(source, gltype, id, severity, message)
+	}, nil)
+}
+
+func hasOpenGLESExtension(name string) bool {
+	return containsExtension(gles2.GoStr(gles2.GetString(gles2.EXTENSIONS)), name)
+}
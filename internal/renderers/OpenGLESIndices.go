@@ -0,0 +1,102 @@
+package renderers
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// indexSegment is a part of the indices of a command that is drawn with 16-bit indices.
+type indexSegment struct {
+	// baseVertex is the vertex of the list that the indices of the segment are relative to.
+	baseVertex int
+	// first and count locate the indices of the segment within the indices of the list.
+	first int
+	count int
+}
+
+// narrowedList holds the 16-bit indices of a command list with 32-bit indices, and the segments that each of its
+// commands is drawn in. The vertices of a segment are at most as far apart as 16-bit indices can address.
+type narrowedList struct {
+	indices  []uint16
+	segments [][]indexSegment
+}
+
+// narrow converts the indices of the list. It returns the number of triangles that can not be drawn,
+// as their own vertices are too far apart.
+func (narrowed *narrowedList) narrow(list imgui.DrawList) int {
+	const bytesPerUint32 = 4
+	indexBuffer, indexBufferSize := list.IndexBuffer()
+	var indices []uint32
+	if indexBufferSize > 0 {
+		indices = unsafe.Slice((*uint32)(indexBuffer), indexBufferSize/bytesPerUint32)
+	}
+	if cap(narrowed.indices) < len(indices) {
+		narrowed.indices = make([]uint16, len(indices))
+	}
+	narrowed.indices = narrowed.indices[:len(indices)]
+
+	commands := list.Commands()
+	if cap(narrowed.segments) < len(commands) {
+		narrowed.segments = append(narrowed.segments[:cap(narrowed.segments)],
+			make([][]indexSegment, len(commands)-cap(narrowed.segments))...)
+	}
+	narrowed.segments = narrowed.segments[:len(commands)]
+	skipped := 0
+	for i, cmd := range commands {
+		first := cmd.IndexOffset()
+		end := first + cmd.ElementCount()
+		if cmd.HasUserCallback() || (end > len(indices)) {
+			narrowed.segments[i] = narrowed.segments[i][:0]
+			continue
+		}
+		var commandSkipped int
+		narrowed.segments[i], commandSkipped = segmentIndices(indices[first:end], first, cmd.VertexOffset(),
+			narrowed.indices, narrowed.segments[i][:0])
+		skipped += commandSkipped
+	}
+	return skipped
+}
+
+// segmentIndices splits the indices of a command, which start at the given index of the list, into segments
+// of whole triangles. It writes the indices relative to the first vertex of their segment into narrowed, and
+// appends the segments. vertexOffset is added to the indices, as imgui does for commands with a vertex offset.
+// Triangles whose own vertices are too far apart are left out, their number is returned.
+func segmentIndices(indices []uint32, first int, vertexOffset int, narrowed []uint16, segments []indexSegment) ([]indexSegment, int) {
+	const maxSpan = math.MaxUint16
+	skipped := 0
+	start := 0
+	low, high := 0, -1
+	flush := func(end int) {
+		if end > start {
+			for i := start; i < end; i++ {
+				narrowed[first+i] = uint16(int(indices[i]) + vertexOffset - low)
+			}
+			segments = append(segments, indexSegment{baseVertex: low, first: first + start, count: end - start})
+		}
+	}
+	triangles := len(indices) - len(indices)%3
+	for i := 0; i < triangles; i += 3 {
+		a, b, c := int(indices[i])+vertexOffset, int(indices[i+1])+vertexOffset, int(indices[i+2])+vertexOffset
+		triangleLow, triangleHigh := min(a, b, c), max(a, b, c)
+		if triangleHigh-triangleLow > maxSpan {
+			flush(i)
+			start, low, high = i+3, 0, -1
+			skipped++
+			continue
+		}
+		if high < low {
+			low, high = triangleLow, triangleHigh
+			continue
+		}
+		if max(high, triangleHigh)-min(low, triangleLow) > maxSpan {
+			flush(i)
+			start, low, high = i, triangleLow, triangleHigh
+			continue
+		}
+		low, high = min(low, triangleLow), max(high, triangleHigh)
+	}
+	flush(triangles)
+	return segments, skipped
+}
@@ -0,0 +1,47 @@
+package renderers
+
+import (
+	"testing"
+)
+
+func TestSegmentIndices(t *testing.T) {
+	// Two triangles near the start, two far beyond 16 bits, one that spans too far on its own, and one near the start.
+	indices := []uint32{
+		0, 1, 2, 2, 1, 3,
+		70000, 70001, 70002, 70002, 70001, 70003,
+		4, 5, 70004,
+		6, 7, 8,
+	}
+	const first = 10
+	narrowed := make([]uint16, first+len(indices))
+	segments, skipped := segmentIndices(indices, first, 0, narrowed, nil)
+
+	if skipped != 1 {
+		t.Errorf("skipped %v triangles, want 1", skipped)
+	}
+	want := []indexSegment{
+		{baseVertex: 0, first: first, count: 6},
+		{baseVertex: 70000, first: first + 6, count: 6},
+		{baseVertex: 6, first: first + 15, count: 3},
+	}
+	if len(segments) != len(want) {
+		t.Fatalf("segments are %v, want %v", segments, want)
+	}
+	for i := range want {
+		if segments[i] != want[i] {
+			t.Errorf("segment %v is %v, want %v", i, segments[i], want[i])
+		}
+	}
+	for _, segment := range segments {
+		for i := segment.first; i < segment.first+segment.count; i++ {
+			if got, want := int(narrowed[i])+segment.baseVertex, int(indices[i-first]); got != want {
+				t.Errorf("index %v addresses vertex %v, want %v", i, got, want)
+			}
+		}
+	}
+
+	segments, _ = segmentIndices([]uint32{0, 1, 2}, 0, 100, narrowed, nil)
+	if (len(segments) != 1) || (segments[0].baseVertex != 100) || (narrowed[2] != 2) {
+		t.Errorf("segments with a vertex offset are %v, with indices %v", segments, narrowed[:3])
+	}
+}
@@ -0,0 +1,37 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v2.0/gles2"
+	gles3 "github.com/jetsetilly/imgui-go-examples/internal/renderers/gl/v3.0/gles2"
+)
+
+// OpenGLESState is a snapshot of the OpenGL ES state that the OpenGLES renderer changes.
+// Only the groups it was captured with are restored.
+type OpenGLESState struct {
+	glState
+
+	es3         bool
+	vertexArray int32
+}
+
+// CaptureOpenGLESState reads the selected groups of state from the current context.
+// The bound vertex array object is only captured for OpenGL ES 3.0 contexts, as indicated by es3,
+// which requires the bindings of OpenGL ES 3.0 to be initialized.
+// Capturing StateTextures leaves the first texture unit active.
+func CaptureOpenGLESState(groups StateGroups, es3 bool) OpenGLESState {
+	state := OpenGLESState{glState: captureGLState(openGLESFunctions, groups), es3: es3}
+
+	if es3 && groups.Has(StateBuffers) {
+		gles2.GetIntegerv(gles3.VERTEX_ARRAY_BINDING, &state.vertexArray)
+	}
+
+	return state
+}
+
+// Restore writes the captured state back to the current context.
+func (state OpenGLESState) Restore() {
+	if state.es3 && state.groups.Has(StateBuffers) {
+		gles3.BindVertexArray(uint32(state.vertexArray))
+	}
+	state.restore(openGLESFunctions)
+}
@@ -0,0 +1,283 @@
+package renderers
+
+import (
+	_ "embed" // using embed for the shader sources
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed gl-shader/main.vert
+var unversionedVertexShader string
+
+//go:embed gl-shader/main.frag
+var unversionedFragmentShader string
+
+//go:embed gl-shader/main_100.vert
+var unversionedVertexShader100 string
+
+//go:embed gl-shader/main_100.frag
+var unversionedFragmentShader100 string
+
+//go:embed gl-shader/sdf.frag
+var unversionedSDFFragmentShader string
+
+// These are the #version directives the renderers choose from when detecting the GLSL version of a context.
+// Any of them can also be passed as an override, for example to NewOpenGL3V().
+const (
+	GLSLVersion130   = "#version 130"
+	GLSLVersion150   = "#version 150"
+	GLSLVersion330   = "#version 330 core"
+	GLSLVersion410   = "#version 410 core"
+	GLSLVersionES100 = "#version 100"
+	GLSLVersionES300 = "#version 300 es"
+)
+
+// parseGLSLVersion extracts the version from the value of GL_SHADING_LANGUAGE_VERSION. The number is major * 100 + minor,
+// e.g. 150 for "1.50 NVIDIA via Cg compiler", or 300 for "OpenGL ES GLSL ES 3.00".
+func parseGLSLVersion(value string) (number int, es bool, err error) {
+	es = strings.Contains(value, " ES")
+	for _, field := range strings.Fields(value) {
+		major, minor, found := strings.Cut(field, ".")
+		if !found {
+			continue
+		}
+		// The minor version has two digits. Some drivers add a release number, as in "1.0.17".
+		minor, _, _ = strings.Cut(minor, ".")
+		if len(minor) > 2 {
+			minor = minor[:2]
+		}
+		majorNumber, majorErr := strconv.Atoi(major)
+		minorNumber, minorErr := strconv.Atoi(minor)
+		if (majorErr != nil) || (minorErr != nil) {
+			continue
+		}
+		if len(minor) == 1 {
+			minorNumber *= 10
+		}
+		return majorNumber*100 + minorNumber, es, nil
+	}
+	return 0, es, fmt.Errorf("%w: %q", ErrUnknownGLSLVersion, value)
+}
+
+// glslVersionFor returns the newest #version directive of the shader variants that the given version supports.
+func glslVersionFor(number int, es bool) string {
+	switch {
+	case es && (number >= 300):
+		return GLSLVersionES300
+	case es:
+		return GLSLVersionES100
+	case number >= 410:
+		return GLSLVersion410
+	case number >= 330:
+		return GLSLVersion330
+	case number >= 150:
+		return GLSLVersion150
+	default:
+		return GLSLVersion130
+	}
+}
+
+// parseGLSLDirective extracts the version from a #version directive, e.g. 330 for "#version 330 core".
+// Version 100 is always GLSL ES.
+func parseGLSLDirective(glslVersion string) (number int, es bool, err error) {
+	fields := strings.Fields(glslVersion)
+	if (len(fields) < 2) || (len(fields) > 3) || (fields[0] != "#version") {
+		return 0, false, fmt.Errorf("%w: %q", ErrUnknownGLSLVersion, glslVersion)
+	}
+	number, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %q", ErrUnknownGLSLVersion, glslVersion)
+	}
+	es = (number == 100) || ((len(fields) == 3) && (fields[2] == "es"))
+	return number, es, nil
+}
+
+// shaderSources returns the sources of the vertex and fragment shader for the given #version directive.
+// Versions before 1.30 and GLSL ES 1.00 use the variant without in/out qualifiers.
+func shaderSources(glslVersion string) (vertexShader string, fragmentShader string, err error) {
+	number, es, err := parseGLSLDirective(glslVersion)
+	if err != nil {
+		return "", "", err
+	}
+
+	vertexBody, fragmentBody := unversionedVertexShader, unversionedFragmentShader
+	if number < 130 {
+		vertexBody, fragmentBody = unversionedVertexShader100, unversionedFragmentShader100
+	}
+
+	return glslVersion + "\n" + vertexBody, fragmentHeader(glslVersion, es) + fragmentBody, nil
+}
+
+// sdfFragmentShaderSource returns the source of the fragment shader for text from a signed distance field,
+// for the given #version directive. There is no variant for the versions without in/out qualifiers.
+func sdfFragmentShaderSource(glslVersion string) (string, error) {
+	number, es, err := parseGLSLDirective(glslVersion)
+	if err != nil {
+		return "", err
+	}
+	if number < 130 {
+		return "", fmt.Errorf("%w: %q", ErrSDFUnsupported, glslVersion)
+	}
+	return fragmentHeader(glslVersion, es) + unversionedSDFFragmentShader, nil
+}
+
+func fragmentHeader(glslVersion string, es bool) string {
+	header := glslVersion + "\n"
+	if es {
+		// GLSL ES has no default precision for floats in fragment shaders.
+		header += "precision mediump float;\n"
+	}
+	return header
+}
+
+// readInfoLog returns the info log of a shader or program, which read copies into the given buffer.
+func readInfoLog(logLength int32, read func(log *uint8)) string {
+	if logLength <= 0 {
+		return "no log available"
+	}
+	log := make([]uint8, logLength)
+	read(&log[0])
+	return strings.TrimSpace(strings.TrimRight(string(log), "\x00"))
+}
+
+// boolToInt32 converts a boolean for a bool uniform, which is set with glUniform1i().
+func boolToInt32(value bool) int32 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// shaderProgram is a linked program of a vertex shader and a fragment shader.
+type shaderProgram struct {
+	handle     uint32
+	vertHandle uint32
+	fragHandle uint32
+}
+
+// attribBinding assigns a location to a vertex attribute before the program is linked.
+type attribBinding struct {
+	name     string
+	location int32
+}
+
+// create compiles the shaders and links them to a program. The attributes are bound to their locations before linking,
+// so that programs can share the vertex attribute setup. If creation fails, the objects created so far are kept
+// for delete(), and the returned error contains the log of the driver.
+func (program *shaderProgram) create(fn *glFunctions, vertexShader, fragmentShader string, fragmentName string,
+	attribs []attribBinding) error {
+	program.handle = fn.CreateProgram()
+	program.vertHandle = fn.CreateShader(glVertexShader)
+	program.fragHandle = fn.CreateShader(glFragmentShader)
+
+	err := compileShader(fn, program.vertHandle, "vertex shader", vertexShader)
+	if err != nil {
+		return err
+	}
+	err = compileShader(fn, program.fragHandle, fragmentName, fragmentShader)
+	if err != nil {
+		return err
+	}
+	fn.AttachShader(program.handle, program.vertHandle)
+	fn.AttachShader(program.handle, program.fragHandle)
+	for _, attrib := range attribs {
+		fn.BindAttribLocation(program.handle, uint32(attrib.location), fn.Str(attrib.name+"\x00"))
+	}
+	return linkProgram(fn, program.handle)
+}
+
+// delete releases the program and its shaders. It does nothing for objects that were not created.
+func (program *shaderProgram) delete(fn *glFunctions) {
+	if (program.handle != 0) && (program.vertHandle != 0) {
+		fn.DetachShader(program.handle, program.vertHandle)
+	}
+	if program.vertHandle != 0 {
+		fn.DeleteShader(program.vertHandle)
+	}
+	if (program.handle != 0) && (program.fragHandle != 0) {
+		fn.DetachShader(program.handle, program.fragHandle)
+	}
+	if program.fragHandle != 0 {
+		fn.DeleteShader(program.fragHandle)
+	}
+	if program.handle != 0 {
+		fn.DeleteProgram(program.handle)
+	}
+	*program = shaderProgram{}
+}
+
+// shaderLocation names a uniform or vertex attribute of a program, and where its location is stored.
+type shaderLocation struct {
+	location *int32
+	lookup   func(fn *glFunctions, program uint32, name string) (int32, error)
+	name     string
+}
+
+// lookupLocations stores the locations of the given inputs. It fails if the program lacks one of them.
+func (program *shaderProgram) lookupLocations(fn *glFunctions, locations []shaderLocation) error {
+	for _, entry := range locations {
+		location, err := entry.lookup(fn, program.handle, entry.name)
+		if err != nil {
+			return err
+		}
+		*entry.location = location
+	}
+	return nil
+}
+
+// compileShader compiles the source into the given shader object.
+// If compilation fails, the returned error contains the log of the driver.
+func compileShader(fn *glFunctions, handle uint32, name string, source string) error {
+	csource, free := fn.Strs(source + "\x00")
+	defer free()
+
+	fn.ShaderSource(handle, 1, csource, nil)
+	fn.CompileShader(handle)
+
+	var status int32
+	fn.GetShaderiv(handle, glCompileStatus, &status)
+	if status == glFalse {
+		var logLength int32
+		fn.GetShaderiv(handle, glInfoLogLength, &logLength)
+		return fmt.Errorf("%w: %s: %s", ErrShaderCompile, name, readInfoLog(logLength, func(log *uint8) {
+			fn.GetShaderInfoLog(handle, logLength, nil, log)
+		}))
+	}
+	return nil
+}
+
+// linkProgram links the attached shaders of the program.
+// If linking fails, the returned error contains the log of the driver.
+func linkProgram(fn *glFunctions, handle uint32) error {
+	fn.LinkProgram(handle)
+
+	var status int32
+	fn.GetProgramiv(handle, glLinkStatus, &status)
+	if status == glFalse {
+		var logLength int32
+		fn.GetProgramiv(handle, glInfoLogLength, &logLength)
+		return fmt.Errorf("%w: %s", ErrShaderLink, readInfoLog(logLength, func(log *uint8) {
+			fn.GetProgramInfoLog(handle, logLength, nil, log)
+		}))
+	}
+	return nil
+}
+
+// uniformLocation returns the location of the named uniform of the program.
+func uniformLocation(fn *glFunctions, program uint32, name string) (int32, error) {
+	location := fn.GetUniformLocation(program, fn.Str(name+"\x00"))
+	if location < 0 {
+		return location, fmt.Errorf("%w: uniform %s", ErrShaderLocation, name)
+	}
+	return location, nil
+}
+
+// attribLocation returns the location of the named vertex attribute of the program.
+func attribLocation(fn *glFunctions, program uint32, name string) (int32, error) {
+	location := fn.GetAttribLocation(program, fn.Str(name+"\x00"))
+	if location < 0 {
+		return location, fmt.Errorf("%w: attribute %s", ErrShaderLocation, name)
+	}
+	return location, nil
+}
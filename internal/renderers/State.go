@@ -0,0 +1,145 @@
+package renderers
+
+// StateGroups selects groups of OpenGL state, which the renderers save before rendering and restore afterwards.
+//
+// Saving state requires reading it back from the driver, which costs a round-trip per value. Applications that
+// do not use OpenGL themselves, or that set up all of the state they need anyway, can reduce the groups to save,
+// or disable saving entirely with StateNone.
+//
+// Groups that do not apply to a renderer are ignored by it.
+type StateGroups uint
+
+// This is a list of StateGroups constants.
+const (
+	// StateTextures covers the active texture unit, and the 2D texture and sampler bound to the first unit.
+	StateTextures StateGroups = 1 << iota
+	// StateProgram covers the current shader program.
+	StateProgram
+	// StateBuffers covers the bound vertex array, array buffer, and element array buffer.
+	StateBuffers
+	// StateBlend covers whether blending is enabled, the blend functions and blend equations.
+	StateBlend
+	// StateCapabilities covers whether face culling, depth test, and scissor test are enabled,
+	// and for the OpenGL3 renderer, whether sRGB encoding is enabled.
+	StateCapabilities
+	// StateViewport covers the viewport, the scissor box, and the polygon mode.
+	StateViewport
+	// StateTransform covers the matrix mode, and the projection and modelview matrices of the fixed-function pipeline.
+	StateTransform
+
+	// StateNone disables saving and restoring of state.
+	StateNone StateGroups = 0
+	// StateAll covers all groups. This is the default of the renderers.
+	StateAll = StateTextures | StateProgram | StateBuffers | StateBlend | StateCapabilities | StateViewport | StateTransform
+)
+
+// Has returns true if all of the given groups are selected.
+func (groups StateGroups) Has(other StateGroups) bool {
+	return (groups & other) == other
+}
+
+// glState is a snapshot of the state that all shader based renderers change. The snapshots of the renderers
+// extend it with the state that only their API has.
+type glState struct {
+	groups StateGroups
+
+	activeTexture int32
+	texture       int32
+
+	program int32
+
+	arrayBuffer        int32
+	elementArrayBuffer int32
+
+	enableBlend        bool
+	blendSrcRgb        int32
+	blendDstRgb        int32
+	blendSrcAlpha      int32
+	blendDstAlpha      int32
+	blendEquationRgb   int32
+	blendEquationAlpha int32
+
+	enableCullFace    bool
+	enableDepthTest   bool
+	enableScissorTest bool
+
+	viewport   [4]int32
+	scissorBox [4]int32
+}
+
+// captureGLState reads the selected groups of state from the current context.
+// Capturing StateTextures leaves the first texture unit active.
+func captureGLState(fn *glFunctions, groups StateGroups) glState {
+	state := glState{groups: groups}
+
+	if groups.Has(StateTextures) {
+		fn.GetIntegerv(glActiveTexture, &state.activeTexture)
+		fn.ActiveTexture(glTexture0)
+		fn.GetIntegerv(glTextureBinding2D, &state.texture)
+	}
+	if groups.Has(StateProgram) {
+		fn.GetIntegerv(glCurrentProgram, &state.program)
+	}
+	if groups.Has(StateBuffers) {
+		fn.GetIntegerv(glArrayBufferBinding, &state.arrayBuffer)
+		fn.GetIntegerv(glElementArrayBufferBinding, &state.elementArrayBuffer)
+	}
+	if groups.Has(StateBlend) {
+		state.enableBlend = fn.IsEnabled(glBlend)
+		fn.GetIntegerv(glBlendSrcRGB, &state.blendSrcRgb)
+		fn.GetIntegerv(glBlendDstRGB, &state.blendDstRgb)
+		fn.GetIntegerv(glBlendSrcAlpha, &state.blendSrcAlpha)
+		fn.GetIntegerv(glBlendDstAlpha, &state.blendDstAlpha)
+		fn.GetIntegerv(glBlendEquationRGB, &state.blendEquationRgb)
+		fn.GetIntegerv(glBlendEquationAlpha, &state.blendEquationAlpha)
+	}
+	if groups.Has(StateCapabilities) {
+		state.enableCullFace = fn.IsEnabled(glCullFace)
+		state.enableDepthTest = fn.IsEnabled(glDepthTest)
+		state.enableScissorTest = fn.IsEnabled(glScissorTest)
+	}
+	if groups.Has(StateViewport) {
+		fn.GetIntegerv(glViewport, &state.viewport[0])
+		fn.GetIntegerv(glScissorBox, &state.scissorBox[0])
+	}
+
+	return state
+}
+
+// restore writes the captured state back to the current context.
+// The element array buffer is restored into the vertex array object that is bound at the time.
+func (state glState) restore(fn *glFunctions) {
+	if state.groups.Has(StateProgram) {
+		fn.UseProgram(uint32(state.program))
+	}
+	if state.groups.Has(StateTextures) {
+		fn.BindTexture(glTexture2D, uint32(state.texture))
+		fn.ActiveTexture(uint32(state.activeTexture))
+	}
+	if state.groups.Has(StateBuffers) {
+		fn.BindBuffer(glArrayBuffer, uint32(state.arrayBuffer))
+		fn.BindBuffer(glElementArrayBuffer, uint32(state.elementArrayBuffer))
+	}
+	if state.groups.Has(StateBlend) {
+		fn.BlendEquationSeparate(uint32(state.blendEquationRgb), uint32(state.blendEquationAlpha))
+		fn.BlendFuncSeparate(uint32(state.blendSrcRgb), uint32(state.blendDstRgb), uint32(state.blendSrcAlpha), uint32(state.blendDstAlpha))
+		setCapability(fn, glBlend, state.enableBlend)
+	}
+	if state.groups.Has(StateCapabilities) {
+		setCapability(fn, glCullFace, state.enableCullFace)
+		setCapability(fn, glDepthTest, state.enableDepthTest)
+		setCapability(fn, glScissorTest, state.enableScissorTest)
+	}
+	if state.groups.Has(StateViewport) {
+		fn.Viewport(state.viewport[0], state.viewport[1], state.viewport[2], state.viewport[3])
+		fn.Scissor(state.scissorBox[0], state.scissorBox[1], state.scissorBox[2], state.scissorBox[3])
+	}
+}
+
+func setCapability(fn *glFunctions, capability uint32, enabled bool) {
+	if enabled {
+		fn.Enable(capability)
+	} else {
+		fn.Disable(capability)
+	}
+}
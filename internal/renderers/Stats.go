@@ -0,0 +1,164 @@
+package renderers
+
+import (
+	"time"
+)
+
+// FrameStats are the numbers a renderer collects while rendering a frame, from one call of NewFrame() to the next.
+// They only cover Render(), not the draws of OpenGL3.RenderOffscreen() into offscreen targets.
+type FrameStats struct {
+	// DrawCalls is the number of draw commands sent to OpenGL.
+	DrawCalls int
+	// Triangles and Vertices are the number of triangles drawn, and the number of vertices of the draw lists.
+	Triangles int
+	Vertices  int
+	// TextureBinds and ScissorChanges count the changes of the bound texture and the scissor box.
+	// Draw commands that use the same texture, or the same clip rectangle, as the previous one do not change them.
+	TextureBinds   int
+	ScissorChanges int
+	// UploadBytes is the number of bytes of vertices and indices that are sent to OpenGL.
+	UploadBytes int
+
+	// GPUTime is the time the GPU took to execute the commands of Render(), without those of RenderOffscreen().
+	// It is only valid if HasGPUTime is true, which requires support for timer queries.
+	//
+	// The measurement of a frame becomes available once the GPU has finished the frame, which is usually one or two frames
+	// later, so GPUTime belongs to an earlier frame than the other numbers.
+	GPUTime    time.Duration
+	HasGPUTime bool
+}
+
+// frameStats collects the numbers of the current frame, and keeps those of the previous one.
+type frameStats struct {
+	current  FrameStats
+	previous FrameStats
+}
+
+// nextFrame finishes the current frame.
+func (stats *frameStats) nextFrame() {
+	stats.previous = stats.current
+	stats.current = FrameStats{}
+}
+
+// drawTracker follows the texture and the scissor box that draw commands set, so that unchanged values are not set again.
+type drawTracker struct {
+	stats *FrameStats
+
+	texture      uint32
+	textureKnown bool
+	scissor      [4]int32
+	scissorKnown bool
+}
+
+func newDrawTracker(stats *FrameStats) drawTracker {
+	return drawTracker{stats: stats}
+}
+
+// forget is called whenever code outside of the renderer may have changed the texture or the scissor box.
+func (tracker *drawTracker) forget() {
+	tracker.textureKnown = false
+	tracker.scissorKnown = false
+}
+
+// bindTexture returns true if the texture has to be bound.
+func (tracker *drawTracker) bindTexture(texture uint32) bool {
+	if tracker.textureKnown && (tracker.texture == texture) {
+		return false
+	}
+	tracker.texture, tracker.textureKnown = texture, true
+	tracker.stats.TextureBinds++
+	return true
+}
+
+// setScissor returns true if the scissor box has to be set.
+func (tracker *drawTracker) setScissor(scissor [4]int32) bool {
+	if tracker.scissorKnown && (tracker.scissor == scissor) {
+		return false
+	}
+	tracker.scissor, tracker.scissorKnown = scissor, true
+	tracker.stats.ScissorChanges++
+	return true
+}
+
+// draw counts a draw command with the given number of indices.
+func (tracker *drawTracker) draw(elementCount int) {
+	tracker.stats.DrawCalls++
+	tracker.stats.Triangles += elementCount / 3
+}
+
+// timerQueryAPI wraps the functions for timer queries of the OpenGL bindings of a renderer.
+type timerQueryAPI interface {
+	genQueries(queries []uint32)
+	deleteQueries(queries []uint32)
+	begin(query uint32)
+	end()
+	available(query uint32) bool
+	// result returns the measured time in nanoseconds.
+	result(query uint32) uint64
+}
+
+// timerQueries measures the GPU time of a section of each frame with two GL_TIME_ELAPSED queries, which are used in turns.
+// Results are only read once they are available, so that measuring never waits for the GPU. If the query of a frame
+// is still pending when it is due again, that frame is not measured.
+type timerQueries struct {
+	api     timerQueryAPI
+	queries [2]uint32
+	pending [2]bool
+	next    int
+	active  bool
+
+	elapsed  time.Duration
+	measured bool
+}
+
+func newTimerQueries(api timerQueryAPI) *timerQueries {
+	timer := &timerQueries{api: api}
+	api.genQueries(timer.queries[:])
+	return timer
+}
+
+func (timer *timerQueries) delete() {
+	if timer == nil {
+		return
+	}
+	timer.api.deleteQueries(timer.queries[:])
+}
+
+// begin starts measuring, after collecting the results that have become available.
+func (timer *timerQueries) begin() {
+	if timer == nil {
+		return
+	}
+	// The query that is due next is the older one.
+	for n := range timer.queries {
+		i := (timer.next + n) % len(timer.queries)
+		if timer.pending[i] && timer.api.available(timer.queries[i]) {
+			timer.elapsed = time.Duration(timer.api.result(timer.queries[i]))
+			timer.measured = true
+			timer.pending[i] = false
+		}
+	}
+	if timer.pending[timer.next] {
+		return
+	}
+	timer.api.begin(timer.queries[timer.next])
+	timer.active = true
+}
+
+// end stops measuring.
+func (timer *timerQueries) end() {
+	if (timer == nil) || !timer.active {
+		return
+	}
+	timer.api.end()
+	timer.active = false
+	timer.pending[timer.next] = true
+	timer.next = (timer.next + 1) % len(timer.queries)
+}
+
+// addTo sets the latest measurement in the stats.
+func (timer *timerQueries) addTo(stats *FrameStats) {
+	if timer != nil {
+		stats.GPUTime, stats.HasGPUTime = timer.elapsed, timer.measured
+	}
+}
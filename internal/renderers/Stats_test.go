@@ -0,0 +1,104 @@
+package renderers
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeTimerQueries completes the queries when told to, with the number of the frame as result.
+type fakeTimerQueries struct {
+	frame     uint64
+	started   map[uint32]uint64
+	completed map[uint32]bool
+}
+
+func (fake *fakeTimerQueries) genQueries(queries []uint32) {
+	for i := range queries {
+		queries[i] = uint32(i + 1)
+	}
+}
+
+func (fake *fakeTimerQueries) deleteQueries(queries []uint32) {}
+
+func (fake *fakeTimerQueries) begin(query uint32) {
+	fake.started[query] = fake.frame
+	fake.completed[query] = false
+}
+
+func (fake *fakeTimerQueries) end() {}
+
+func (fake *fakeTimerQueries) available(query uint32) bool {
+	return fake.completed[query]
+}
+
+func (fake *fakeTimerQueries) result(query uint32) uint64 {
+	if !fake.completed[query] {
+		panic("result of a pending query")
+	}
+	return fake.started[query]
+}
+
+// completeAll lets the GPU catch up with all frames.
+func (fake *fakeTimerQueries) completeAll() {
+	for query := range fake.started {
+		fake.completed[query] = true
+	}
+}
+
+func TestTimerQueries(t *testing.T) {
+	fake := &fakeTimerQueries{started: make(map[uint32]uint64), completed: make(map[uint32]bool)}
+	timer := newTimerQueries(fake)
+	var stats FrameStats
+
+	measure := func() {
+		fake.frame++
+		timer.begin()
+		timer.end()
+		timer.addTo(&stats)
+	}
+
+	measure()
+	if stats.HasGPUTime {
+		t.Fatalf("measurement before the GPU finished the first frame")
+	}
+
+	// The GPU falls behind: The third frame is not measured, as both queries are pending.
+	measure()
+	measure()
+	if len(fake.started) != 2 || fake.started[1] != 1 || fake.started[2] != 2 {
+		t.Fatalf("queries were started in frames %v, want frames 1 and 2", fake.started)
+	}
+
+	fake.completeAll()
+	measure()
+	if !stats.HasGPUTime || (stats.GPUTime != time.Duration(2)) {
+		t.Fatalf("measurement is %v (%v), want the one of frame 2", stats.GPUTime, stats.HasGPUTime)
+	}
+	if fake.started[1] != 4 {
+		t.Fatalf("query 1 was started in frame %d, want frame 4", fake.started[1])
+	}
+}
+
+func TestDrawTracker(t *testing.T) {
+	var stats FrameStats
+	tracker := newDrawTracker(&stats)
+
+	binds := []bool{tracker.bindTexture(1), tracker.bindTexture(1), tracker.bindTexture(2)}
+	tracker.forget()
+	binds = append(binds, tracker.bindTexture(2))
+	want := []bool{true, false, true, true}
+	for i := range want {
+		if binds[i] != want[i] {
+			t.Fatalf("binds are %v, want %v", binds, want)
+		}
+	}
+	if stats.TextureBinds != 3 {
+		t.Fatalf("counted %d texture binds, want 3", stats.TextureBinds)
+	}
+
+	tracker.setScissor([4]int32{0, 0, 10, 10})
+	tracker.setScissor([4]int32{0, 0, 10, 10})
+	if stats.ScissorChanges != 1 {
+		t.Fatalf("counted %d scissor changes, want 1", stats.ScissorChanges)
+	}
+}
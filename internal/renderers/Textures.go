@@ -0,0 +1,205 @@
+package renderers
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"unsafe"
+)
+
+// TextureFilter selects how a texture is sampled when it is drawn scaled.
+type TextureFilter int
+
+// This is a list of TextureFilter constants.
+const (
+	TextureFilterLinear TextureFilter = iota
+	TextureFilterNearest
+)
+
+// TextureWrap selects how texture coordinates outside of the range [0, 1] are handled.
+type TextureWrap int
+
+// This is a list of TextureWrap constants.
+const (
+	TextureWrapClamp TextureWrap = iota
+	TextureWrapRepeat
+	TextureWrapMirroredRepeat
+)
+
+// TextureOptions describe the sampling of a user texture. The zero value is linear filtering with clamped coordinates.
+type TextureOptions struct {
+	Filter TextureFilter
+	Wrap   TextureWrap
+}
+
+// userTextures creates and updates the textures of a renderer that are created on behalf of the user,
+// and tracks them along with their size.
+type userTextures struct {
+	fn    *glFunctions
+	sizes map[uint32]image.Point
+}
+
+func newUserTextures(fn *glFunctions) userTextures {
+	return userTextures{fn: fn, sizes: make(map[uint32]image.Point)}
+}
+
+// create uploads the image into a new texture with the given internal format.
+func (textures userTextures) create(img image.Image, options TextureOptions, internalFormat int32) uint32 {
+	size := img.Bounds().Size()
+	pixels, rowLength := texturePixels(img)
+
+	var lastTexture int32
+	textures.fn.GetIntegerv(glTextureBinding2D, &lastTexture)
+
+	var texture uint32
+	textures.fn.GenTextures(1, &texture)
+	textures.fn.BindTexture(glTexture2D, texture)
+	textures.applyOptions(options)
+	textures.fn.PixelStorei(glUnpackRowLength, rowLength)
+	textures.fn.TexImage2D(glTexture2D, 0, internalFormat, int32(size.X), int32(size.Y), 0, glRGBA, glUnsignedByte, pixels)
+	textures.fn.PixelStorei(glUnpackRowLength, 0)
+
+	textures.fn.BindTexture(glTexture2D, uint32(lastTexture))
+
+	textures.sizes[texture] = size
+	return texture
+}
+
+// update replaces the region of the texture that the bounds of the image cover.
+func (textures userTextures) update(texture uint32, img image.Image) error {
+	region, err := textures.region(texture, img)
+	if err != nil {
+		return err
+	}
+	pixels, rowLength := texturePixels(img)
+
+	var lastTexture int32
+	textures.fn.GetIntegerv(glTextureBinding2D, &lastTexture)
+
+	textures.fn.BindTexture(glTexture2D, texture)
+	textures.fn.PixelStorei(glUnpackRowLength, rowLength)
+	textures.fn.TexSubImage2D(glTexture2D, 0, int32(region.Min.X), int32(region.Min.Y), int32(region.Dx()), int32(region.Dy()),
+		glRGBA, glUnsignedByte, pixels)
+	textures.fn.PixelStorei(glUnpackRowLength, 0)
+
+	textures.fn.BindTexture(glTexture2D, uint32(lastTexture))
+	return nil
+}
+
+// region returns the area of the texture that the image covers, or an error if the image does not fit.
+func (textures userTextures) region(texture uint32, img image.Image) (image.Rectangle, error) {
+	size, known := textures.sizes[texture]
+	if !known {
+		return image.Rectangle{}, ErrUnknownTexture
+	}
+	bounds := img.Bounds()
+	if !bounds.In(image.Rectangle{Max: size}) {
+		return image.Rectangle{}, ErrTextureBounds
+	}
+	return bounds, nil
+}
+
+func (textures userTextures) setOptions(texture uint32, options TextureOptions) error {
+	if _, known := textures.sizes[texture]; !known {
+		return ErrUnknownTexture
+	}
+
+	var lastTexture int32
+	textures.fn.GetIntegerv(glTextureBinding2D, &lastTexture)
+	textures.fn.BindTexture(glTexture2D, texture)
+	textures.applyOptions(options)
+	textures.fn.BindTexture(glTexture2D, uint32(lastTexture))
+	return nil
+}
+
+// applyOptions sets the parameters of the currently bound texture.
+func (textures userTextures) applyOptions(options TextureOptions) {
+	filter := int32(glLinear)
+	if options.Filter == TextureFilterNearest {
+		filter = glNearest
+	}
+	wrap := int32(glClampToEdge)
+	switch options.Wrap {
+	case TextureWrapRepeat:
+		wrap = glRepeat
+	case TextureWrapMirroredRepeat:
+		wrap = glMirroredRepeat
+	}
+
+	textures.fn.TexParameteri(glTexture2D, glTextureMinFilter, filter)
+	textures.fn.TexParameteri(glTexture2D, glTextureMagFilter, filter)
+	textures.fn.TexParameteri(glTexture2D, glTextureWrapS, wrap)
+	textures.fn.TexParameteri(glTexture2D, glTextureWrapT, wrap)
+}
+
+// free releases the texture. Unknown textures are ignored.
+func (textures userTextures) free(texture uint32) {
+	if _, known := textures.sizes[texture]; !known {
+		return
+	}
+	textures.fn.DeleteTextures(1, &texture)
+	delete(textures.sizes, texture)
+}
+
+func (textures userTextures) freeAll() {
+	for texture := range textures.sizes {
+		textures.free(texture)
+	}
+}
+
+// texturePixels returns the pixels of the image as non-premultiplied RGBA, which is what the renderers blend with.
+// The returned row length is the number of pixels between the starts of two rows, which may be more than the width of the image.
+// The pointer is nil for empty images.
+func texturePixels(img image.Image) (pixels unsafe.Pointer, rowLength int32) {
+	converted := toNRGBA(img)
+	if len(converted.Pix) == 0 {
+		return nil, 0
+	}
+	const bytesPerPixel = 4
+	return unsafe.Pointer(&converted.Pix[0]), int32(converted.Stride / bytesPerPixel)
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+
+	switch src := img.(type) {
+	case *image.NRGBA:
+		return src
+	case *image.RGBA:
+		dst := image.NewNRGBA(bounds)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				dst.SetNRGBA(x, y, color.NRGBAModel.Convert(src.RGBAAt(x, y)).(color.NRGBA))
+			}
+		}
+		return dst
+	case *image.Gray:
+		dst := image.NewNRGBA(bounds)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				gray := src.GrayAt(x, y).Y
+				dst.SetNRGBA(x, y, color.NRGBA{R: gray, G: gray, B: gray, A: 0xFF})
+			}
+		}
+		return dst
+	case *image.Paletted:
+		palette := make([]color.NRGBA, len(src.Palette))
+		for i, entry := range src.Palette {
+			palette[i] = color.NRGBAModel.Convert(entry).(color.NRGBA)
+		}
+		dst := image.NewNRGBA(bounds)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				index := int(src.ColorIndexAt(x, y))
+				if index < len(palette) {
+					dst.SetNRGBA(x, y, palette[index])
+				}
+			}
+		}
+		return dst
+	default:
+		dst := image.NewNRGBA(bounds)
+		draw.Draw(dst, bounds, img, bounds.Min, draw.Src)
+		return dst
+	}
+}
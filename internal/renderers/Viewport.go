@@ -0,0 +1,82 @@
+package renderers
+
+import (
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// drawViewport describes where the draw data of a frame is rendered to.
+// The visible imgui space lies from displayPos (top left) to displayPos+displaySize (bottom right).
+// displayPos is typically (0,0) for the main viewport, but not for other viewports or sub-regions.
+type drawViewport struct {
+	displayPos      imgui.Vec2
+	displaySize     imgui.Vec2
+	framebufferSize imgui.Vec2
+	clipScale       imgui.Vec2
+}
+
+// newDrawViewport determines the viewport of the draw data, with the framebuffer size derived
+// from the framebuffer scale of the draw data (screen coordinates != framebuffer coordinates on retina displays).
+func newDrawViewport(drawData imgui.DrawData) drawViewport {
+	displaySize := drawData.DisplaySize()
+	scale := drawData.FrameBufferScale()
+	return newDrawViewportV(drawData, imgui.Vec2{X: displaySize.X * scale.X, Y: displaySize.Y * scale.Y})
+}
+
+// newDrawViewportV determines the viewport of the draw data, with the display area scaled to the given framebuffer size.
+func newDrawViewportV(drawData imgui.DrawData, framebufferSize imgui.Vec2) drawViewport {
+	viewport := drawViewport{
+		displayPos:      drawData.DisplayPos(),
+		displaySize:     drawData.DisplaySize(),
+		framebufferSize: framebufferSize,
+	}
+	if (viewport.displaySize.X > 0) && (viewport.displaySize.Y > 0) {
+		viewport.clipScale = imgui.Vec2{
+			X: framebufferSize.X / viewport.displaySize.X,
+			Y: framebufferSize.Y / viewport.displaySize.Y,
+		}
+	}
+	return viewport
+}
+
+// empty returns true if there is nothing to render, for example because the window is minimized.
+func (viewport drawViewport) empty() bool {
+	return (viewport.framebufferSize.X <= 0) || (viewport.framebufferSize.Y <= 0) ||
+		(viewport.displaySize.X <= 0) || (viewport.displaySize.Y <= 0)
+}
+
+// orthoProjection returns the projection matrix that maps the display area to clip space, in column-major order.
+func (viewport drawViewport) orthoProjection() [4][4]float32 {
+	left := viewport.displayPos.X
+	right := viewport.displayPos.X + viewport.displaySize.X
+	top := viewport.displayPos.Y
+	bottom := viewport.displayPos.Y + viewport.displaySize.Y
+	return [4][4]float32{
+		{2.0 / (right - left), 0.0, 0.0, 0.0},
+		{0.0, 2.0 / (top - bottom), 0.0, 0.0},
+		{0.0, 0.0, -1.0, 0.0},
+		{(right + left) / (left - right), (top + bottom) / (bottom - top), 0.0, 1.0},
+	}
+}
+
+// scissor converts a clip rectangle of a draw command into a scissor box in framebuffer pixels,
+// with the origin at the bottom left. It returns false if nothing of the command is visible.
+// The clip rectangles are scaled per command, instead of via drawData.ScaleClipRects(), so that the
+// same draw data can be rendered more than once, e.g. into an OffscreenTarget as well.
+func (viewport drawViewport) scissor(clipRect imgui.Vec4) (x, y, width, height int32, visible bool) {
+	minX := (clipRect.X - viewport.displayPos.X) * viewport.clipScale.X
+	minY := (clipRect.Y - viewport.displayPos.Y) * viewport.clipScale.Y
+	maxX := (clipRect.Z - viewport.displayPos.X) * viewport.clipScale.X
+	maxY := (clipRect.W - viewport.displayPos.Y) * viewport.clipScale.Y
+	// Clip rectangles of windows that are partly off-screen start outside of the framebuffer.
+	// Fractional negative minimums would be truncated towards zero, which shifts the box instead of cutting it.
+	if minX < 0 {
+		minX = 0
+	}
+	if minY < 0 {
+		minY = 0
+	}
+	if (maxX <= minX) || (maxY <= minY) {
+		return 0, 0, 0, 0, false
+	}
+	return int32(minX), int32(viewport.framebufferSize.Y - maxY), int32(maxX - minX), int32(maxY - minY), true
+}
@@ -0,0 +1,37 @@
+package renderers
+
+import (
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestDrawViewportScissor(t *testing.T) {
+	viewport := drawViewport{
+		displaySize:     imgui.Vec2{X: 100, Y: 50},
+		framebufferSize: imgui.Vec2{X: 200, Y: 100},
+		clipScale:       imgui.Vec2{X: 2, Y: 2},
+	}
+	tests := []struct {
+		name     string
+		clipRect imgui.Vec4
+		want     [4]int32
+		visible  bool
+	}{
+		{name: "inside", clipRect: imgui.Vec4{X: 10, Y: 10, Z: 20, W: 30}, want: [4]int32{20, 40, 20, 40}, visible: true},
+		{name: "partly off-screen", clipRect: imgui.Vec4{X: -10.25, Y: -5.25, Z: 20, W: 30}, want: [4]int32{0, 40, 40, 60}, visible: true},
+		{name: "off-screen", clipRect: imgui.Vec4{X: -30, Y: 10, Z: -10, W: 30}},
+		{name: "empty", clipRect: imgui.Vec4{X: 10, Y: 10, Z: 10, W: 30}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			x, y, width, height, visible := viewport.scissor(test.clipRect)
+			if visible != test.visible {
+				t.Fatalf("visible is %v, want %v", visible, test.visible)
+			}
+			if got := [4]int32{x, y, width, height}; visible && (got != test.want) {
+				t.Errorf("scissor box is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package renderers
+
+// StringError describes a basic error with static information.
+type StringError string
+
+// Error returns the string itself.
+func (err StringError) Error() string {
+	return string(err)
+}
+
+const (
+	// ErrUnknownTexture is used in case a texture ID does not refer to a texture that was created by the renderer.
+	ErrUnknownTexture = StringError("unknown texture")
+	// ErrTextureBounds is used in case an image does not fit into the bounds of a texture.
+	ErrTextureBounds = StringError("image outside of texture bounds")
+	// ErrEmptyCapture is used in case a capture would not contain any pixels, for example because nothing has been rendered yet.
+	ErrEmptyCapture = StringError("capture region is empty")
+	// ErrIncompleteFramebuffer is used in case a framebuffer object can not be used for rendering.
+	ErrIncompleteFramebuffer = StringError("incomplete framebuffer")
+	// ErrInvalidTargetSize is used in case an offscreen target would not contain any pixels.
+	ErrInvalidTargetSize = StringError("offscreen target size must be positive")
+	// ErrDebugUnsupported is used in case the context provides no debug output.
+	ErrDebugUnsupported = StringError("debug output not supported")
+	// ErrUnknownGLSLVersion is used in case a version of the OpenGL shading language can not be parsed.
+	ErrUnknownGLSLVersion = StringError("unknown GLSL version")
+	// ErrShaderCompile is used in case the driver fails to compile a shader of a renderer.
+	ErrShaderCompile = StringError("failed to compile shader")
+	// ErrShaderLink is used in case the driver fails to link the shader program of a renderer.
+	ErrShaderLink = StringError("failed to link shader program")
+	// ErrSDFUnsupported is used in case the shading language of the context can not render text from a signed distance field.
+	ErrSDFUnsupported = StringError("signed distance field fonts not supported")
+	// ErrShaderLocation is used in case a uniform or vertex attribute the renderer requires is missing from its shader program.
+	ErrShaderLocation = StringError("missing shader input")
+	// ErrSRGBUnsupported is used in case the default framebuffer does not encode colors to sRGB, see platforms.Options.
+	ErrSRGBUnsupported = StringError("sRGB framebuffer not supported")
+)
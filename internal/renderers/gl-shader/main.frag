@@ -1,4 +1,5 @@
 uniform sampler2D Texture;
+uniform bool AlphaTexture;
 
 in vec2 Frag_UV;
 in vec4 Frag_Color;
@@ -7,5 +8,14 @@ out vec4 Out_Color;
 
 void main()
 {
-    Out_Color = vec4(Frag_Color.rgb, Frag_Color.a * texture(Texture, Frag_UV.st).r);
+    vec4 texel = texture(Texture, Frag_UV.st);
+    if (AlphaTexture)
+    {
+        // Single-channel textures, such as the Alpha8 font atlas, only hold the coverage in the red channel.
+        Out_Color = vec4(Frag_Color.rgb, Frag_Color.a * texel.r);
+    }
+    else
+    {
+        Out_Color = Frag_Color * texel;
+    }
 }
@@ -0,0 +1,19 @@
+uniform sampler2D Texture;
+uniform bool AlphaTexture;
+
+varying vec2 Frag_UV;
+varying vec4 Frag_Color;
+
+void main()
+{
+    vec4 texel = texture2D(Texture, Frag_UV.st);
+    if (AlphaTexture)
+    {
+        // Single-channel textures, such as the Alpha8 font atlas, only hold the coverage in the red channel.
+        gl_FragColor = vec4(Frag_Color.rgb, Frag_Color.a * texel.r);
+    }
+    else
+    {
+        gl_FragColor = Frag_Color * texel;
+    }
+}
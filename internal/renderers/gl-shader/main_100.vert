@@ -0,0 +1,26 @@
+uniform mat4 ProjMtx;
+uniform bool LinearColor;
+
+attribute vec2 Position;
+attribute vec2 UV;
+attribute vec4 Color;
+
+varying vec2 Frag_UV;
+varying vec4 Frag_Color;
+
+// srgbToLinear decodes sRGB colors, so that they are blended in linear space. The framebuffer encodes them again.
+vec3 srgbToLinear(vec3 color)
+{
+    return mix(color / 12.92, pow((color + 0.055) / 1.055, vec3(2.4)), step(0.04045, color));
+}
+
+void main()
+{
+    Frag_UV = UV;
+    Frag_Color = Color;
+    if (LinearColor)
+    {
+        Frag_Color.rgb = srgbToLinear(Color.rgb);
+    }
+    gl_Position = ProjMtx * vec4(Position.xy, 0, 1);
+}
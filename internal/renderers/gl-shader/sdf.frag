@@ -0,0 +1,37 @@
+uniform sampler2D Texture;
+uniform vec4 OutlineColor;
+uniform float OutlineWidth;
+uniform vec4 ShadowColor;
+uniform vec2 ShadowOffset;
+
+in vec2 Frag_UV;
+in vec4 Frag_Color;
+
+out vec4 Out_Color;
+
+// over composites a layer on top of another, with colors that are not premultiplied.
+vec4 over(vec4 top, vec4 bottom)
+{
+    float alpha = top.a + bottom.a * (1.0 - top.a);
+    if (alpha <= 0.0)
+    {
+        return vec4(0.0);
+    }
+    return vec4((top.rgb * top.a + bottom.rgb * bottom.a * (1.0 - top.a)) / alpha, alpha);
+}
+
+void main()
+{
+    // The edge of the glyphs is at 0.5. The width of the transition is one pixel on screen, at any scale.
+    float distance = texture(Texture, Frag_UV.st).r;
+    float smoothing = max(fwidth(distance), 1.0 / 255.0) * 0.5;
+
+    float fill = smoothstep(0.5 - smoothing, 0.5 + smoothing, distance);
+    float outline = smoothstep(0.5 - OutlineWidth - smoothing, 0.5 - OutlineWidth + smoothing, distance);
+    float shadowDistance = texture(Texture, Frag_UV.st - ShadowOffset).r;
+    float shadow = smoothstep(0.5 - OutlineWidth - smoothing, 0.5 - OutlineWidth + smoothing, shadowDistance);
+
+    vec4 color = vec4(Frag_Color.rgb, Frag_Color.a * fill);
+    color = over(color, vec4(OutlineColor.rgb, OutlineColor.a * Frag_Color.a * outline));
+    Out_Color = over(color, vec4(ShadowColor.rgb, ShadowColor.a * Frag_Color.a * shadow));
+}
@@ -0,0 +1,176 @@
+package shaping
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/go-text/typesetting/font"
+	"golang.org/x/image/vector"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/fonts"
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
+)
+
+const (
+	// glyphPageSize is the width and height of each texture the glyphs are packed into.
+	glyphPageSize = 512
+	// glyphPadding is the number of transparent pixels around each glyph, so that linear filtering
+	// does not pick up neighbouring glyphs.
+	glyphPadding = 1
+)
+
+type glyphKey struct {
+	face int
+	id   font.GID
+}
+
+// cachedGlyph is a rasterized glyph, and where to find it in the pages of the cache.
+type cachedGlyph struct {
+	// empty is true for glyphs without an outline, such as spaces.
+	empty bool
+
+	texture imgui.TextureID
+	uv0     imgui.Vec2
+	uv1     imgui.Vec2
+	// offset is the top left corner of the bitmap relative to the origin of the glyph, and size its size in pixels.
+	offset imgui.Vec2
+	size   imgui.Vec2
+}
+
+// glyphPage is a texture that glyphs are packed into, in rows from top to bottom.
+type glyphPage struct {
+	texture   imgui.TextureID
+	pixels    *image.NRGBA
+	cursor    image.Point
+	rowHeight int
+}
+
+// glyphCache rasterizes glyphs on first use. Glyphs are never evicted: When a page is full, a new one is started.
+type glyphCache struct {
+	textures Textures
+	faces    []*font.Face
+	ppem     float32
+
+	glyphs     map[glyphKey]cachedGlyph
+	pages      []*glyphPage
+	rasterizer vector.Rasterizer
+}
+
+func newGlyphCache(textures Textures, faces []*font.Face, ppem float32) *glyphCache {
+	return &glyphCache{
+		textures: textures,
+		faces:    faces,
+		ppem:     ppem,
+		glyphs:   make(map[glyphKey]cachedGlyph),
+	}
+}
+
+func (cache *glyphCache) dispose() {
+	for _, page := range cache.pages {
+		cache.textures.FreeTexture(page.texture)
+	}
+	cache.pages = nil
+	cache.glyphs = make(map[glyphKey]cachedGlyph)
+}
+
+func (cache *glyphCache) lookup(face int, id font.GID) cachedGlyph {
+	key := glyphKey{face: face, id: id}
+	glyph, cached := cache.glyphs[key]
+	if !cached {
+		glyph = cache.rasterize(key)
+		cache.glyphs[key] = glyph
+	}
+	return glyph
+}
+
+// rasterize draws the outline of the glyph into a page, and uploads the area of the glyph to its texture.
+// Glyphs that do not fit into a page are treated as empty.
+func (cache *glyphCache) rasterize(key glyphKey) cachedGlyph {
+	face := cache.faces[key.face]
+	outline, hasOutline := face.GlyphDataOutline(key.id)
+	if !hasOutline || (len(outline.Segments) == 0) {
+		return cachedGlyph{empty: true}
+	}
+	scale := cache.ppem / float32(face.Upem())
+
+	// The control points of the outline contain the glyph. Font units grow upwards, pixels downwards.
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := -minX, -minY
+	for i := range outline.Segments {
+		for _, point := range outline.Segments[i].ArgsSlice() {
+			minX = min(minX, point.X*scale)
+			maxX = max(maxX, point.X*scale)
+			minY = min(minY, -point.Y*scale)
+			maxY = max(maxY, -point.Y*scale)
+		}
+	}
+	bounds := image.Rect(
+		int(math.Floor(float64(minX))), int(math.Floor(float64(minY))),
+		int(math.Ceil(float64(maxX))), int(math.Ceil(float64(maxY))))
+	size := bounds.Size()
+	if (size.X <= 0) || (size.Y <= 0) || (size.X+2*glyphPadding > glyphPageSize) || (size.Y+2*glyphPadding > glyphPageSize) {
+		return cachedGlyph{empty: true}
+	}
+
+	origin := imgui.Vec2{X: -float32(bounds.Min.X), Y: -float32(bounds.Min.Y)}
+	fonts.DrawOutline(&cache.rasterizer, outline, scale, origin, size)
+	coverage := image.NewAlpha(image.Rectangle{Max: size})
+	cache.rasterizer.Draw(coverage, coverage.Bounds(), image.Opaque, image.Point{})
+
+	page, pos := cache.allocate(size)
+	area := image.Rectangle{Min: pos, Max: pos.Add(size)}
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			page.pixels.SetNRGBA(pos.X+x, pos.Y+y, color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: coverage.AlphaAt(x, y).A})
+		}
+	}
+	// This only fails if the texture was freed by someone else.
+	err := cache.textures.UpdateTexture(page.texture, page.pixels.SubImage(area))
+	if err != nil {
+		return cachedGlyph{empty: true}
+	}
+
+	return cachedGlyph{
+		texture: page.texture,
+		uv0:     imgui.Vec2{X: float32(area.Min.X) / glyphPageSize, Y: float32(area.Min.Y) / glyphPageSize},
+		uv1:     imgui.Vec2{X: float32(area.Max.X) / glyphPageSize, Y: float32(area.Max.Y) / glyphPageSize},
+		offset:  imgui.Vec2{X: float32(bounds.Min.X), Y: float32(bounds.Min.Y)},
+		size:    imgui.Vec2{X: float32(size.X), Y: float32(size.Y)},
+	}
+}
+
+// allocate reserves an area of the given size in the current page, or in a new page if the current one is full.
+func (cache *glyphCache) allocate(size image.Point) (*glyphPage, image.Point) {
+	if len(cache.pages) > 0 {
+		page := cache.pages[len(cache.pages)-1]
+		if page.cursor.X+size.X+glyphPadding > glyphPageSize {
+			page.cursor = image.Point{X: glyphPadding, Y: page.cursor.Y + page.rowHeight + glyphPadding}
+			page.rowHeight = 0
+		}
+		if page.cursor.Y+size.Y+glyphPadding <= glyphPageSize {
+			return page, page.reserve(size)
+		}
+	}
+
+	page := &glyphPage{
+		pixels: image.NewNRGBA(image.Rect(0, 0, glyphPageSize, glyphPageSize)),
+		cursor: image.Point{X: glyphPadding, Y: glyphPadding},
+	}
+	// Transparent white, so that filtering at the edges of the glyphs does not darken them.
+	for i := 0; i < len(page.pixels.Pix); i += 4 {
+		page.pixels.Pix[i], page.pixels.Pix[i+1], page.pixels.Pix[i+2] = 0xFF, 0xFF, 0xFF
+	}
+	page.texture = cache.textures.CreateTexture(page.pixels, renderers.TextureOptions{})
+	cache.pages = append(cache.pages, page)
+	return page, page.reserve(size)
+}
+
+func (page *glyphPage) reserve(size image.Point) image.Point {
+	pos := page.cursor
+	page.cursor.X += size.X + glyphPadding
+	page.rowHeight = max(page.rowHeight, size.Y)
+	return pos
+}
@@ -0,0 +1,82 @@
+package shaping
+
+import (
+	"github.com/go-text/typesetting/bidi"
+	"github.com/go-text/typesetting/font"
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Glyph is a glyph of a font, positioned within a line.
+type Glyph struct {
+	// ID identifies the glyph within the font of its run.
+	ID font.GID
+	// Pos is the origin of the glyph, relative to the start of the line on its baseline. Y grows downwards, as in imgui.
+	Pos imgui.Vec2
+	// Cluster is the index of the first rune of the text that the glyph was shaped from.
+	Cluster int
+}
+
+// Run is a sequence of glyphs that were shaped together, with the same font, script and direction.
+type Run struct {
+	// Glyphs are ordered from left to right, regardless of the direction of the run.
+	Glyphs []Glyph
+	// RightToLeft is true for runs of right-to-left scripts, such as Arabic and Hebrew.
+	RightToLeft bool
+
+	face    int
+	level   bidi.Level
+	advance float32
+}
+
+// Line is a line of shaped text, with its runs in visual order from left to right.
+type Line struct {
+	Runs []Run
+	// Width is the sum of the advances of all glyphs.
+	Width float32
+	// Ascent and Descent are the distances from the baseline to the top and bottom of the line.
+	Ascent  float32
+	Descent float32
+}
+
+// Height returns the height of the line.
+func (line Line) Height() float32 {
+	return line.Ascent + line.Descent
+}
+
+// reorderRuns puts the runs of a line from logical into visual order, as in rule L2 of the
+// Unicode bidirectional algorithm: From the highest embedding level down to the lowest odd level,
+// every sequence of runs at that level or higher is reversed.
+func reorderRuns(runs []Run) {
+	if len(runs) == 0 {
+		return
+	}
+	highest := runs[0].level
+	for _, run := range runs {
+		if run.level > highest {
+			highest = run.level
+		}
+	}
+	lowestOdd := highest + 1
+	for _, run := range runs {
+		if (run.level%2 == 1) && (run.level < lowestOdd) {
+			lowestOdd = run.level
+		}
+	}
+
+	for level := highest; level >= lowestOdd; level-- {
+		for start := 0; start < len(runs); {
+			if runs[start].level < level {
+				start++
+				continue
+			}
+			end := start + 1
+			for (end < len(runs)) && (runs[end].level >= level) {
+				end++
+			}
+			for i, j := start, end-1; i < j; i, j = i+1, j-1 {
+				runs[i], runs[j] = runs[j], runs[i]
+			}
+			start = end
+		}
+	}
+}
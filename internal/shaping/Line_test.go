@@ -0,0 +1,44 @@
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/bidi"
+)
+
+func TestReorderRuns(t *testing.T) {
+	tests := []struct {
+		name   string
+		levels []bidi.Level
+		want   []int
+	}{
+		{name: "left-to-right", levels: []bidi.Level{0, 0, 0}, want: []int{0, 1, 2}},
+		{name: "right-to-left", levels: []bidi.Level{1, 1, 1}, want: []int{2, 1, 0}},
+		{name: "embedded right-to-left", levels: []bidi.Level{0, 1, 1, 0}, want: []int{0, 2, 1, 3}},
+		{name: "numbers in right-to-left", levels: []bidi.Level{1, 2, 2, 1}, want: []int{3, 1, 2, 0}},
+		{name: "numbers in embedded right-to-left", levels: []bidi.Level{0, 1, 2, 1, 0}, want: []int{0, 3, 2, 1, 4}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runs := make([]Run, len(test.levels))
+			for i, level := range test.levels {
+				runs[i] = Run{level: level, face: i}
+			}
+			reorderRuns(runs)
+			for i, run := range runs {
+				if run.face != test.want[i] {
+					t.Fatalf("visual order is %v, want %v", visualOrder(runs), test.want)
+				}
+			}
+		})
+	}
+}
+
+func visualOrder(runs []Run) []int {
+	order := make([]int, len(runs))
+	for i, run := range runs {
+		order[i] = run.face
+	}
+	return order
+}
@@ -0,0 +1,273 @@
+package shaping
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io/fs"
+	"math"
+	"os"
+
+	"github.com/go-text/typesetting/bidi"
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/shaping"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/fonts"
+	"github.com/jetsetilly/imgui-go-examples/internal/renderers"
+)
+
+// maxCachedLines limits the number of lines that Text() keeps shaped between frames.
+const maxCachedLines = 256
+
+// Textures creates and updates the textures the glyphs are rasterized into.
+// It is implemented by the renderers that support user textures, such as renderers.OpenGL3.
+type Textures interface {
+	CreateTexture(img image.Image, options renderers.TextureOptions) imgui.TextureID
+	UpdateTexture(id imgui.TextureID, img image.Image) error
+	FreeTexture(id imgui.TextureID)
+}
+
+// Shaper shapes text with a list of fonts, and draws the shaped text into draw lists.
+// Each character is shaped with the first font that has a glyph for it.
+//
+// Glyphs are rasterized when they are first drawn, which uploads them to their texture right away.
+// The shaper must therefore only be used while the context of the renderer is current,
+// which is the case between the calls to NewFrame() and Render() of the renderer.
+type Shaper struct {
+	faces    faceList
+	ppem     fixed.Int26_6
+	ascent   float32
+	descent  float32
+	language language.Language
+
+	paragraph bidi.Paragraph
+	segmenter shaping.Segmenter
+	shaper    shaping.HarfbuzzShaper
+
+	glyphs *glyphCache
+	lines  map[string]Line
+}
+
+// defaultFontSize is the size of the default font of imgui, which the shaper matches if no fonts are given.
+const defaultFontSize = 13
+
+// NewShaper loads the given fonts for shaping. The text is shaped at the size of the first font, which is the
+// height of its lines in pixels as with the font atlas of imgui. Other than that, only the file of each font is used.
+//
+// Without fonts, the text is shaped with Go Regular of golang.org/x/image/font/gofont, at the size of the default font of imgui.
+func NewShaper(textures Textures, fontList ...fonts.Font) (*Shaper, error) {
+	shaper := &Shaper{
+		language: language.DefaultLanguage(),
+		lines:    make(map[string]Line),
+	}
+
+	size := float32(defaultFontSize)
+	if len(fontList) == 0 {
+		face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load default font: %w", err)
+		}
+		shaper.faces = append(shaper.faces, face)
+	} else {
+		size = fontList[0].Size
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSize, size)
+	}
+	for _, desc := range fontList {
+		face, err := loadFace(desc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load font %q: %w", desc.Path, err)
+		}
+		shaper.faces = append(shaper.faces, face)
+	}
+
+	// imgui scales fonts so that the distance from the ascender to the descender is the size of the font,
+	// while the shaper takes the size of the em square.
+	primary := shaper.faces[0]
+	upem := float32(primary.Upem())
+	ascender, descender := upem, float32(0)
+	if extents, hasExtents := primary.FontHExtents(); hasExtents && (extents.Ascender > extents.Descender) {
+		ascender, descender = extents.Ascender, extents.Descender
+	}
+	ppem := math.Max(1, math.Round(float64(size*upem/(ascender-descender))))
+	shaper.ppem = fixed.I(int(ppem))
+	scale := float32(ppem) / upem
+	shaper.ascent = ascender * scale
+	shaper.descent = -descender * scale
+
+	shaper.glyphs = newGlyphCache(textures, shaper.faces, float32(ppem))
+	return shaper, nil
+}
+
+func loadFace(desc fonts.Font) (*font.Face, error) {
+	var data []byte
+	var err error
+	if desc.FS != nil {
+		data, err = fs.ReadFile(desc.FS, desc.Path)
+	} else {
+		data, err = os.ReadFile(desc.Path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return font.ParseTTF(bytes.NewReader(data))
+}
+
+// Dispose releases the textures of the glyphs.
+func (shaper *Shaper) Dispose() {
+	shaper.glyphs.dispose()
+}
+
+// Shape shapes the text into a single line. Line breaks are not taken into account.
+//
+// The direction of the line is taken from the first character of the text with a strong direction,
+// and is left-to-right if there is none. Runs of the other direction are reordered accordingly.
+func (shaper *Shaper) Shape(text string) Line {
+	line := Line{Ascent: shaper.ascent, Descent: shaper.descent}
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return line
+	}
+
+	bidiRuns := shaper.paragraph.Segment(runes, bidi.Neutral)
+	levelAt := func(index int) bidi.Level {
+		for i := 0; i < bidiRuns.NumRuns(); i++ {
+			run := bidiRuns.Run(i)
+			if index < run.End {
+				return run.Level
+			}
+		}
+		return 0
+	}
+	// The segmenter applies the bidirectional algorithm again, in the direction of the input,
+	// which has to be the one of the paragraph for the levels to match.
+	direction := di.DirectionLTR
+	if paragraphLevel(bidiRuns)%2 == 1 {
+		direction = di.DirectionRTL
+	}
+
+	input := shaping.Input{
+		Text:      runes,
+		RunStart:  0,
+		RunEnd:    len(runes),
+		Direction: direction,
+		Size:      shaper.ppem,
+		Language:  shaper.language,
+	}
+	for _, segment := range shaper.segmenter.Split(input, shaper.faces) {
+		output := shaper.shaper.Shape(segment)
+		run := Run{
+			Glyphs:      make([]Glyph, 0, len(output.Glyphs)),
+			RightToLeft: segment.Direction.Progression() == di.TowardTopLeft,
+			face:        shaper.faces.index(output.Face),
+			level:       levelAt(segment.RunStart),
+		}
+		// The glyphs of right-to-left runs are already in visual order.
+		for _, glyph := range output.Glyphs {
+			run.Glyphs = append(run.Glyphs, Glyph{
+				ID:      glyph.GlyphID,
+				Pos:     imgui.Vec2{X: run.advance + fixedToFloat(glyph.XOffset), Y: -fixedToFloat(glyph.YOffset)},
+				Cluster: glyph.TextIndex(),
+			})
+			run.advance += fixedToFloat(glyph.Advance)
+		}
+		line.Runs = append(line.Runs, run)
+	}
+
+	reorderRuns(line.Runs)
+	for i := range line.Runs {
+		run := &line.Runs[i]
+		for j := range run.Glyphs {
+			run.Glyphs[j].Pos.X += line.Width
+		}
+		line.Width += run.advance
+	}
+	return line
+}
+
+// Draw adds the glyphs of the line to the draw list. The position is the top left corner of the line.
+// Glyphs are placed at whole pixels, as they are rasterized at whole pixels.
+func (shaper *Shaper) Draw(list imgui.DrawList, pos imgui.Vec2, line Line, color imgui.PackedColor) {
+	baseline := imgui.Vec2{X: pos.X, Y: pos.Y + line.Ascent}
+	for _, run := range line.Runs {
+		for _, glyph := range run.Glyphs {
+			cached := shaper.glyphs.lookup(run.face, glyph.ID)
+			if cached.empty {
+				continue
+			}
+			min := imgui.Vec2{
+				X: roundToPixel(baseline.X+glyph.Pos.X) + cached.offset.X,
+				Y: roundToPixel(baseline.Y+glyph.Pos.Y) + cached.offset.Y,
+			}
+			max := imgui.Vec2{X: min.X + cached.size.X, Y: min.Y + cached.size.Y}
+			list.AddImageV(cached.texture, min, max, cached.uv0, cached.uv1, color)
+		}
+	}
+}
+
+// Text adds the shaped text to the current window, in the text color of the style, like imgui.Text() does.
+// The lines are kept shaped between frames, so that only new text is shaped.
+func (shaper *Shaper) Text(text string) {
+	line, cached := shaper.lines[text]
+	if !cached {
+		if len(shaper.lines) >= maxCachedLines {
+			shaper.lines = make(map[string]Line)
+		}
+		line = shaper.Shape(text)
+		shaper.lines[text] = line
+	}
+
+	pos := imgui.CursorScreenPos()
+	imgui.Dummy(imgui.Vec2{X: line.Width, Y: line.Height()})
+	color := imgui.PackedColorFromVec4(imgui.CurrentStyle().Color(imgui.StyleColorText))
+	shaper.Draw(imgui.WindowDrawList(), pos, line, color)
+}
+
+// paragraphLevel returns the embedding level of the paragraph, which is the lowest level of its runs.
+func paragraphLevel(runs bidi.Runs) bidi.Level {
+	level := runs.Run(0).Level
+	for i := 1; i < runs.NumRuns(); i++ {
+		if run := runs.Run(i); run.Level < level {
+			level = run.Level
+		}
+	}
+	return level
+}
+
+func fixedToFloat(value fixed.Int26_6) float32 {
+	return float32(value) / 64
+}
+
+func roundToPixel(value float32) float32 {
+	return float32(math.Round(float64(value)))
+}
+
+// faceList resolves the face of each character to the first face that has a glyph for it.
+// Characters without a glyph in any face are shaped with the first face.
+type faceList []*font.Face
+
+// ResolveFace implements the shaping.Fontmap interface.
+func (faces faceList) ResolveFace(r rune) *font.Face {
+	for _, face := range faces {
+		if _, hasGlyph := face.NominalGlyph(r); hasGlyph {
+			return face
+		}
+	}
+	return faces[0]
+}
+
+func (faces faceList) index(face *font.Face) int {
+	for i := range faces {
+		if faces[i] == face {
+			return i
+		}
+	}
+	return 0
+}
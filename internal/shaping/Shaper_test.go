@@ -0,0 +1,59 @@
+package shaping
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jetsetilly/imgui-go-examples/internal/fonts"
+)
+
+func TestShape(t *testing.T) {
+	testdata := os.DirFS("testdata")
+	shaper, err := NewShaper(nil,
+		fonts.Font{FS: testdata, Path: "DejaVuSans-Subset.ttf", Size: 16},
+		fonts.Font{FS: testdata, Path: "NotoSansArabic-Subset.ttf", Size: 16})
+	if err != nil {
+		t.Fatalf("failed to create shaper: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		text  string
+		left  int
+		right int
+	}{
+		{name: "left-to-right", text: "abc", left: 0, right: 2},
+		{name: "right-to-left", text: "سلام", left: 3, right: 0},
+		{name: "right-to-left in left-to-right", text: "abc سلام", left: 0, right: 4},
+		{name: "left-to-right in right-to-left", text: "سلام abc", left: 5, right: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			line := shaper.Shape(test.text)
+			if line.Width <= 0 {
+				t.Errorf("line has a width of %v", line.Width)
+			}
+			if line.Height() <= 0 {
+				t.Errorf("line has a height of %v", line.Height())
+			}
+
+			var clusters []int
+			lastX := float32(-1)
+			for _, run := range line.Runs {
+				for _, glyph := range run.Glyphs {
+					if glyph.Pos.X < lastX {
+						t.Errorf("glyph of cluster %v is at %v, left of the previous glyph at %v", glyph.Cluster, glyph.Pos.X, lastX)
+					}
+					lastX = glyph.Pos.X
+					clusters = append(clusters, glyph.Cluster)
+				}
+			}
+			if len(clusters) == 0 {
+				t.Fatal("line has no glyphs")
+			}
+			if left, right := clusters[0], clusters[len(clusters)-1]; (left != test.left) || (right != test.right) {
+				t.Errorf("clusters from left to right are %v, want %v first and %v last", clusters, test.left, test.right)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Package shaping lays out text with OpenType shaping, for scripts that imgui can not render
+// codepoint by codepoint, such as Thai with its combining marks, or Arabic with its joining forms.
+// Strings are turned into positioned glyph runs in visual order, with right-to-left text reordered
+// according to the Unicode bidirectional algorithm. The glyphs are rasterized into textures of their own,
+// and drawn through the draw lists of imgui.
+//
+// Shaping and rasterization are done in pure Go, with the shaper of github.com/go-text/typesetting
+// and the rasterizer of golang.org/x/image/vector.
+package shaping
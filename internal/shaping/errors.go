@@ -0,0 +1,14 @@
+package shaping
+
+// StringError describes a basic error with static information.
+type StringError string
+
+// Error returns the string itself.
+func (err StringError) Error() string {
+	return string(err)
+}
+
+const (
+	// ErrInvalidSize is used in case the size of the first font is not positive.
+	ErrInvalidSize = StringError("invalid font size")
+)